@@ -108,13 +108,15 @@ var (
 	PrintPrefixed                      = input.PrintPrefixed
 
 	// variable aliases
-	LineBreak  = flags.LineBreak
-	GasFlagVar = flags.GasFlagVar
+	LineBreak         = flags.LineBreak
+	GasFlagVar        = flags.GasFlagVar
+	FeeGranterFlagVar = flags.FeeGranterFlagVar
 )
 
 type (
 	CLIContext             = context.CLIContext
 	GasSetting             = flags.GasSetting
+	FeeGranterSetting      = flags.FeeGranterSetting
 	AddNewKey              = keys.AddNewKey
 	RecoverKey             = keys.RecoverKey
 	UpdateKeyReq           = keys.UpdateKeyReq
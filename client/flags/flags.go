@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/viper"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 // nolint
@@ -44,6 +46,8 @@ const (
 	FlagMemo               = "memo"
 	FlagFees               = "fees"
 	FlagGasPrices          = "gas-prices"
+	FlagFeeGranter         = "fee-granter"
+	FlagDisplayUnits       = "display-units"
 	FlagBroadcastMode      = "broadcast-mode"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
@@ -59,8 +63,9 @@ const (
 // LineBreak can be included in a command list to provide a blank line
 // to help with readability
 var (
-	LineBreak  = &cobra.Command{Run: func(*cobra.Command, []string) {}}
-	GasFlagVar = GasSetting{Gas: DefaultGasLimit}
+	LineBreak         = &cobra.Command{Run: func(*cobra.Command, []string) {}}
+	GasFlagVar        = GasSetting{Gas: DefaultGasLimit}
+	FeeGranterFlagVar = FeeGranterSetting{}
 )
 
 // GetCommands adds common flags to query commands
@@ -91,6 +96,7 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 		c.Flags().String(FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uatom)")
+		c.Flags().Var(&FeeGranterFlagVar, FlagFeeGranter, "Address of the account paying the fees out of a fee allowance granted to the signer")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -167,6 +173,33 @@ func ParseGas(gasStr string) (simulateAndExecute bool, gas uint64, err error) {
 	return
 }
 
+// FeeGranterSetting encapsulates the address passed through the --fee-granter
+// flag.
+type FeeGranterSetting struct {
+	Granter sdk.AccAddress
+}
+
+// Type returns the flag's value type.
+func (v *FeeGranterSetting) Type() string { return "string" }
+
+// Set parses and sets the value of the --fee-granter flag.
+func (v *FeeGranterSetting) Set(s string) (err error) {
+	if s == "" {
+		v.Granter = nil
+		return nil
+	}
+
+	v.Granter, err = sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return fmt.Errorf("invalid fee granter address %q: %s", s, err)
+	}
+	return nil
+}
+
+func (v *FeeGranterSetting) String() string {
+	return v.Granter.String()
+}
+
 // NewCompletionCmd builds a cobra.Command that generate bash completion
 // scripts for the given root command. If hidden is true, the command
 // will not show up in the root command's list of available commands.
@@ -15,18 +15,27 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/types/module"
 	"github.com/hyperspeednetwork/hsnhub/version"
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/authz"
 	"github.com/hyperspeednetwork/hsnhub/x/bank"
 	"github.com/hyperspeednetwork/hsnhub/x/crisis"
 	distr "github.com/hyperspeednetwork/hsnhub/x/distribution"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant"
 	"github.com/hyperspeednetwork/hsnhub/x/genaccounts"
 	"github.com/hyperspeednetwork/hsnhub/x/genutil"
 	"github.com/hyperspeednetwork/hsnhub/x/gov"
+	govclient "github.com/hyperspeednetwork/hsnhub/x/gov/client"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc"
 	"github.com/hyperspeednetwork/hsnhub/x/mint"
 	"github.com/hyperspeednetwork/hsnhub/x/params"
 	paramsclient "github.com/hyperspeednetwork/hsnhub/x/params/client"
 	"github.com/hyperspeednetwork/hsnhub/x/slashing"
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
 	"github.com/hyperspeednetwork/hsnhub/x/supply"
+	supplyclient "github.com/hyperspeednetwork/hsnhub/x/supply/client"
+	"github.com/hyperspeednetwork/hsnhub/x/token"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade"
+	upgradeclient "github.com/hyperspeednetwork/hsnhub/x/upgrade/client"
 )
 
 const appName = "SimApp"
@@ -49,24 +58,47 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler),
+		gov.NewAppModuleBasic(
+			paramsclient.ProposalHandler, distr.ProposalHandler,
+			distr.StreamProposalHandler, distr.CancelStreamProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			supplyclient.ProposalHandler, govclient.ExecProposalHandler,
+		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
+		evidence.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		token.AppModuleBasic{},
+		htlc.AppModuleBasic{},
 	)
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		mint.ModuleName:           {supply.Minter},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            {supply.Burner},
+		auth.FeeCollectorName:         nil,
+		distr.ModuleName:              nil,
+		mint.ModuleName:               {supply.Minter},
+		staking.BondedPoolName:        {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:     {supply.Burner, supply.Staking},
+		staking.TokenizeSharePoolName: {supply.Minter, supply.Burner},
+		gov.ModuleName:                {supply.Burner},
+		token.ModuleName:              {supply.Minter, supply.Burner},
+		htlc.ModuleName:               nil,
 	}
 )
 
+func init() {
+	// allow governance to send coins held by its module account, to fund the
+	// community pool from it and to fund accounts from the community pool
+	gov.RegisterProposalMsgCodec(bank.MsgSend{}, "cosmos-sdk/MsgSend")
+	gov.RegisterProposalMsgCodec(bank.MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
+	gov.RegisterProposalMsgCodec(distr.MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool")
+	gov.RegisterProposalMsgCodec(distr.MsgCommunityPoolSpend{}, "cosmos-sdk/MsgCommunityPoolSpend")
+}
+
 // custom tx codec
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
@@ -98,6 +130,12 @@ type SimApp struct {
 	govKeeper      gov.Keeper
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
+	upgradeKeeper  upgrade.Keeper
+	evidenceKeeper evidence.Keeper
+	authzKeeper    authz.Keeper
+	feeGrantKeeper feegrant.Keeper
+	tokenKeeper    token.Keeper
+	htlcKeeper     htlc.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -117,7 +155,8 @@ func NewSimApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey)
+		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
+		authz.StoreKey, feegrant.StoreKey, token.StoreKey, htlc.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &SimApp{
@@ -147,18 +186,37 @@ func NewSimApp(
 		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
 	app.mintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], mintSubspace, &stakingKeeper, app.supplyKeeper, auth.FeeCollectorName)
 	app.distrKeeper = distr.NewKeeper(app.cdc, keys[distr.StoreKey], distrSubspace, &stakingKeeper,
-		app.supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
+		app.supplyKeeper, app.mintKeeper, distr.DefaultCodespace, auth.FeeCollectorName, app.ModuleAccountAddrs())
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
+	app.upgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], app.cdc, DefaultNodeHome)
+	app.tokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], app.supplyKeeper, app.bankKeeper, token.DefaultCodespace)
+	app.htlcKeeper = htlc.NewKeeper(app.cdc, keys[htlc.StoreKey], app.supplyKeeper, htlc.DefaultCodespace)
+
+	// create evidence keeper with the evidence router; handlers for custom
+	// evidence types must be registered on the router before it is set
+	evidenceKeeper := evidence.NewKeeper(app.cdc, keys[evidence.StoreKey], app.slashingKeeper, evidence.DefaultCodespace)
+	evidenceRouter := evidence.NewRouter()
+	evidenceKeeper.SetRouter(evidenceRouter)
+	app.evidenceKeeper = evidenceKeeper
+
+	// the authz keeper dispatches the messages executed on behalf of granters
+	// through the app's message router
+	app.authzKeeper = authz.NewKeeper(app.cdc, keys[authz.StoreKey], app.Router(), authz.DefaultCodespace)
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey], feegrant.DefaultCodespace)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper))
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(supply.RouterKey, supply.NewDenomMetadataProposalHandler(app.supplyKeeper))
+	// the gov keeper dispatches the messages of passed exec proposals through
+	// the app's message router
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], govSubspace,
-		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
+		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter, app.Router())
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -180,21 +238,33 @@ func NewSimApp(
 		mint.NewAppModule(app.mintKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
+		evidence.NewAppModule(app.evidenceKeeper),
+		authz.NewAppModule(app.authzKeeper),
+		feegrant.NewAppModule(app.feeGrantKeeper),
+		token.NewAppModule(app.tokenKeeper),
+		htlc.NewAppModule(app.htlcKeeper, app.supplyKeeper, app.bankKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
-	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// CanWithdrawInvariant invariant. The upgrade module must run first so that
+	// migrations are applied before any other module touches the state.
+	app.mm.SetOrderBeginBlockers(
+		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
+		evidence.ModuleName, staking.ModuleName,
+	)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, htlc.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
 		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName,
+		mint.ModuleName, supply.ModuleName, crisis.ModuleName, evidence.ModuleName,
+		authz.ModuleName, feegrant.ModuleName, token.ModuleName, htlc.ModuleName,
+		genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -207,7 +277,7 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.feeGrantKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
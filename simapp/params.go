@@ -2,22 +2,32 @@ package simapp
 
 // Simulation parameter constants
 const (
-	StakePerAccount                                    = "stake_per_account"
-	InitiallyBondedValidators                          = "initially_bonded_validators"
-	OpWeightDeductFee                                  = "op_weight_deduct_fee"
-	OpWeightMsgSend                                    = "op_weight_msg_send"
-	OpWeightSingleInputMsgMultiSend                    = "op_weight_single_input_msg_multisend"
-	OpWeightMsgSetWithdrawAddress                      = "op_weight_msg_set_withdraw_address"
-	OpWeightMsgWithdrawDelegationReward                = "op_weight_msg_withdraw_delegation_reward"
-	OpWeightMsgWithdrawValidatorCommission             = "op_weight_msg_withdraw_validator_commission"
-	OpWeightSubmitVotingSlashingTextProposal           = "op_weight_submit_voting_slashing_text_proposal"
-	OpWeightSubmitVotingSlashingCommunitySpendProposal = "op_weight_submit_voting_slashing_community_spend_proposal"
-	OpWeightSubmitVotingSlashingParamChangeProposal    = "op_weight_submit_voting_slashing_param_change_proposal"
-	OpWeightMsgDeposit                                 = "op_weight_msg_deposit"
-	OpWeightMsgCreateValidator                         = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator                           = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate                                = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate                              = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate                         = "op_weight_msg_begin_redelegate"
-	OpWeightMsgUnjail                                  = "op_weight_msg_unjail"
+	StakePerAccount                                     = "stake_per_account"
+	InitiallyBondedValidators                           = "initially_bonded_validators"
+	OpWeightDeductFee                                   = "op_weight_deduct_fee"
+	OpWeightMsgSend                                     = "op_weight_msg_send"
+	OpWeightSingleInputMsgMultiSend                     = "op_weight_single_input_msg_multisend"
+	OpWeightMsgCreateVestingAccount                     = "op_weight_msg_create_vesting_account"
+	OpWeightMsgSetWithdrawAddress                       = "op_weight_msg_set_withdraw_address"
+	OpWeightMsgWithdrawDelegationReward                 = "op_weight_msg_withdraw_delegation_reward"
+	OpWeightMsgWithdrawValidatorCommission              = "op_weight_msg_withdraw_validator_commission"
+	OpWeightMsgSetAutoRestake                           = "op_weight_msg_set_auto_restake"
+	OpWeightMsgFundCommunityPool                        = "op_weight_msg_fund_community_pool"
+	OpWeightSubmitVotingSlashingTextProposal            = "op_weight_submit_voting_slashing_text_proposal"
+	OpWeightSubmitVotingSlashingExecProposal            = "op_weight_submit_voting_slashing_exec_proposal"
+	OpWeightSubmitVotingSlashingCommunitySpendProposal  = "op_weight_submit_voting_slashing_community_spend_proposal"
+	OpWeightSubmitVotingSlashingCommunityStreamProposal = "op_weight_submit_voting_slashing_community_stream_proposal"
+	OpWeightSubmitVotingSlashingCancelStreamProposal    = "op_weight_submit_voting_slashing_cancel_stream_proposal"
+	OpWeightSubmitVotingSlashingParamChangeProposal     = "op_weight_submit_voting_slashing_param_change_proposal"
+	OpWeightMsgDeposit                                  = "op_weight_msg_deposit"
+	OpWeightMsgCancelProposal                           = "op_weight_msg_cancel_proposal"
+	OpWeightMsgCreateValidator                          = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator                            = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                                 = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate                               = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate                          = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbondingDelegation                = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares                           = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares                    = "op_weight_msg_redeem_tokens_for_shares"
+	OpWeightMsgUnjail                                   = "op_weight_msg_unjail"
 )
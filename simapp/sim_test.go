@@ -21,11 +21,15 @@ import (
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	authsim "github.com/hyperspeednetwork/hsnhub/x/auth/simulation"
+	"github.com/hyperspeednetwork/hsnhub/x/authz"
 	"github.com/hyperspeednetwork/hsnhub/x/bank"
 	distr "github.com/hyperspeednetwork/hsnhub/x/distribution"
 	distrsim "github.com/hyperspeednetwork/hsnhub/x/distribution/simulation"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant"
 	"github.com/hyperspeednetwork/hsnhub/x/gov"
 	govsim "github.com/hyperspeednetwork/hsnhub/x/gov/simulation"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc"
 	"github.com/hyperspeednetwork/hsnhub/x/mint"
 	"github.com/hyperspeednetwork/hsnhub/x/params"
 	paramsim "github.com/hyperspeednetwork/hsnhub/x/params/simulation"
@@ -35,6 +39,8 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
 	stakingsim "github.com/hyperspeednetwork/hsnhub/x/staking/simulation"
 	"github.com/hyperspeednetwork/hsnhub/x/supply"
+	"github.com/hyperspeednetwork/hsnhub/x/token"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade"
 )
 
 func init() {
@@ -208,6 +214,17 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			bank.SimulateSingleInputMsgMultiSend(app.accountKeeper, app.bankKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &v, nil,
+					func(_ *rand.Rand) {
+						v = 10
+					})
+				return v
+			}(nil),
+			bank.SimulateMsgCreateVestingAccount(app.accountKeeper, app.bankKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -241,6 +258,28 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			distrsim.SimulateMsgWithdrawValidatorCommission(app.accountKeeper, app.distrKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgSetAutoRestake, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsim.SimulateMsgSetAutoRestake(app.accountKeeper, app.distrKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgFundCommunityPool, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			distrsim.SimulateMsgFundCommunityPool(app.accountKeeper, app.distrKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -252,6 +291,17 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, govsim.SimulateTextProposalContent),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingExecProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, govsim.SimulateExecProposalContent(app.govKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -263,6 +313,28 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCommunityPoolSpendProposalContent(app.distrKeeper)),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingCommunityStreamProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCommunityPoolStreamProposalContent(app.distrKeeper)),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingCancelStreamProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, distrsim.SimulateCancelCommunityPoolStreamProposalContent(app.distrKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -285,6 +357,17 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateMsgDeposit(app.govKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateMsgCancelProposal(app.govKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -340,6 +423,39 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 			}(nil),
 			stakingsim.SimulateMsgBeginRedelegate(app.accountKeeper, app.stakingKeeper),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgCancelUnbondingDelegation(app.accountKeeper, app.stakingKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgTokenizeShares(app.accountKeeper, app.stakingKeeper),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &v, nil,
+					func(_ *rand.Rand) {
+						v = 50
+					})
+				return v
+			}(nil),
+			stakingsim.SimulateMsgRedeemTokensForShares(app.accountKeeper, app.stakingKeeper),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
@@ -591,6 +707,12 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[supply.StoreKey], newApp.keys[supply.StoreKey], [][]byte{}},
 		{app.keys[params.StoreKey], newApp.keys[params.StoreKey], [][]byte{}},
 		{app.keys[gov.StoreKey], newApp.keys[gov.StoreKey], [][]byte{}},
+		{app.keys[evidence.StoreKey], newApp.keys[evidence.StoreKey], [][]byte{}},
+		{app.keys[authz.StoreKey], newApp.keys[authz.StoreKey], [][]byte{}},
+		{app.keys[feegrant.StoreKey], newApp.keys[feegrant.StoreKey], [][]byte{}},
+		{app.keys[token.StoreKey], newApp.keys[token.StoreKey], [][]byte{}},
+		{app.keys[htlc.StoreKey], newApp.keys[htlc.StoreKey], [][]byte{}},
+		{app.keys[upgrade.StoreKey], newApp.keys[upgrade.StoreKey], [][]byte{}},
 	}
 
 	for _, storeKeysPrefix := range storeKeysPrefixes {
@@ -1,4 +1,4 @@
-//nolint
+// nolint
 package simapp
 
 import (
@@ -27,6 +27,7 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/x/genaccounts"
 	"github.com/hyperspeednetwork/hsnhub/x/gov"
 	"github.com/hyperspeednetwork/hsnhub/x/mint"
+	"github.com/hyperspeednetwork/hsnhub/x/params"
 	"github.com/hyperspeednetwork/hsnhub/x/simulation"
 	"github.com/hyperspeednetwork/hsnhub/x/slashing"
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
@@ -164,6 +165,7 @@ func GenBankGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 				})
 			return v
 		}(r),
+		[]bank.SendEnabled{},
 	)
 
 	fmt.Printf("Selected randomly generated bank parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, bankGenesis))
@@ -175,6 +177,7 @@ func GenSupplyGenesisState(cdc *codec.Codec, amount, numInitiallyBonded, numAccs
 	totalSupply := sdk.NewInt(amount * (numAccs + numInitiallyBonded))
 	supplyGenesis := supply.NewGenesisState(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply)),
+		[]supply.Metadata{},
 	)
 
 	fmt.Printf("Generated supply parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, supplyGenesis))
@@ -219,10 +222,13 @@ func GenGenesisAccounts(
 				endTime++
 			}
 
-			if r.Intn(100) < 50 {
+			switch r.Intn(3) {
+			case 0:
 				vacc = auth.NewContinuousVestingAccount(&bacc, startTime, endTime)
-			} else {
+			case 1:
 				vacc = auth.NewDelayedVestingAccount(&bacc, endTime)
+			default:
+				vacc = auth.NewPeriodicVestingAccount(&bacc, startTime, randomVestingPeriods(r, coins, endTime-startTime))
 			}
 
 			var err error
@@ -240,6 +246,34 @@ func GenGenesisAccounts(
 	genesisState[genaccounts.ModuleName] = cdc.MustMarshalJSON(genesisAccounts)
 }
 
+// randomVestingPeriods splits the given coins into a random number of vesting
+// periods spanning the given duration.
+func randomVestingPeriods(r *rand.Rand, coins sdk.Coins, duration int64) auth.Periods {
+	numPeriods := int64(simulation.RandIntBetween(r, 1, 6))
+	if numPeriods > duration {
+		numPeriods = duration
+	}
+
+	periods := make(auth.Periods, numPeriods)
+	remaining, elapsed := coins, int64(0)
+
+	for i := int64(0); i < numPeriods-1; i++ {
+		length := duration / numPeriods
+		amount := sdk.NewCoins()
+		for _, coin := range coins {
+			amount = amount.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(numPeriods))))
+		}
+
+		periods[i] = auth.NewPeriod(length, amount)
+		remaining = remaining.Sub(amount)
+		elapsed += length
+	}
+
+	// the last period vests whatever is left
+	periods[numPeriods-1] = auth.NewPeriod(duration-elapsed, remaining)
+	return periods
+}
+
 // GenGovGenesisState generates a random GenesisState for gov
 func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
 	var vp time.Duration
@@ -248,20 +282,25 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 			vp = simulation.ModuleParamSimulator[simulation.VotingParamsVotingPeriod](r).(time.Duration)
 		})
 
+	var minDeposit sdk.Coins
+	ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &minDeposit, r,
+		func(r *rand.Rand) {
+			minDeposit = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
+		})
+
+	// expedited proposals need five times the regular deposit and parameter
+	// changes twice the regular deposit
+	expeditedMinDeposit := sdk.NewCoins()
+	paramChangeMinDeposit := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5))))
+		paramChangeMinDeposit = paramChangeMinDeposit.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(2))))
+	}
+
 	govGenesis := gov.NewGenesisState(
 		uint64(r.Intn(100)),
-		gov.NewDepositParams(
-			func(r *rand.Rand) sdk.Coins {
-				var v sdk.Coins
-				ap.GetOrGenerate(cdc, simulation.DepositParamsMinDeposit, &v, r,
-					func(r *rand.Rand) {
-						v = simulation.ModuleParamSimulator[simulation.DepositParamsMinDeposit](r).(sdk.Coins)
-					})
-				return v
-			}(r),
-			vp,
-		),
-		gov.NewVotingParams(vp),
+		gov.NewDepositParams(minDeposit, vp, expeditedMinDeposit, gov.DefaultProposalCancelRatio),
+		gov.NewVotingParams(vp, vp/2),
 		gov.NewTallyParams(
 			func(r *rand.Rand) sdk.Dec {
 				var v sdk.Dec
@@ -287,8 +326,12 @@ func GenGovGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams,
 					})
 				return v
 			}(r),
+			gov.DefaultExpeditedThreshold,
 		),
 	)
+	govGenesis.ProposalTypeParams = gov.ProposalTypeParamsList{
+		gov.NewProposalTypeParams(params.ProposalTypeChange, paramChangeMinDeposit, sdk.Dec{}, sdk.Dec{}),
+	}
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, govGenesis))
 	genesisState[gov.ModuleName] = cdc.MustMarshalJSON(govGenesis)
@@ -352,7 +395,8 @@ func GenMintGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams
 // GenDistrGenesisState generates a random GenesisState for distribution
 func GenDistrGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
 	distrGenesis := distribution.GenesisState{
-		FeePool: distribution.InitialFeePool(),
+		FeePool:                   distribution.InitialFeePool(),
+		NextCommunityPoolStreamID: 1,
 		CommunityTax: func(r *rand.Rand) sdk.Dec {
 			var v sdk.Dec
 			ap.GetOrGenerate(cdc, simulation.CommunityTax, &v, r,
@@ -377,6 +421,30 @@ func GenDistrGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParam
 				})
 			return v
 		}(r),
+		AutoRestakeInterval: func(r *rand.Rand) int64 {
+			var v int64
+			ap.GetOrGenerate(cdc, simulation.AutoRestakeInterval, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.AutoRestakeInterval](r).(int64)
+				})
+			return v
+		}(r),
+		MaxAutoRestakeDelegations: func(r *rand.Rand) uint32 {
+			var v uint32
+			ap.GetOrGenerate(cdc, simulation.MaxAutoRestakeDelegations, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.MaxAutoRestakeDelegations](r).(uint32)
+				})
+			return v
+		}(r),
+		MissedVoteRewardsRecipient: func(r *rand.Rand) string {
+			var v string
+			ap.GetOrGenerate(cdc, simulation.MissedVoteRewardsRecipient, &v, r,
+				func(r *rand.Rand) {
+					v = simulation.ModuleParamSimulator[simulation.MissedVoteRewardsRecipient](r).(string)
+				})
+			return v
+		}(r),
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, distrGenesis))
@@ -465,7 +533,39 @@ func GenStakingGenesisState(
 				return v
 			}(r),
 			7,
+			func(r *rand.Rand) uint16 {
+				var v uint16
+				ap.GetOrGenerate(cdc, simulation.HistoricalEntries, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.HistoricalEntries](r).(uint16)
+					})
+				return v
+			}(r),
 			sdk.DefaultBondDenom,
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.MaxVotingPowerFraction, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.MaxVotingPowerFraction](r).(sdk.Dec)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.VotingPowerSoftLimit, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.VotingPowerSoftLimit](r).(sdk.Dec)
+					})
+				return v
+			}(r),
+			func(r *rand.Rand) sdk.Dec {
+				var v sdk.Dec
+				ap.GetOrGenerate(cdc, simulation.MinCommissionRate, &v, r,
+					func(r *rand.Rand) {
+						v = simulation.ModuleParamSimulator[simulation.MinCommissionRate](r).(sdk.Dec)
+					})
+				return v
+			}(r),
 		),
 		nil,
 		nil,
@@ -616,6 +716,31 @@ func DecodeDistributionStore(cdcA, cdcB *codec.Codec, kvA, kvB cmn.KVPair) strin
 		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], distribution.DelegatorAutoRestakePrefix):
+		return fmt.Sprintf("%v\n%v", distribution.GetDelegatorAutoRestakeAddress(kvA.Key),
+			distribution.GetDelegatorAutoRestakeAddress(kvB.Key))
+
+	case bytes.Equal(kvA.Key[:1], distribution.AutoRestakeCursorKey):
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], distribution.ValidatorMissedRewardsPrefix):
+		var rewardsA, rewardsB distribution.ValidatorMissedRewards
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rewardsA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rewardsB)
+		return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+	case bytes.Equal(kvA.Key[:1], distribution.CommunityPoolStreamPrefix):
+		var streamA, streamB distribution.CommunityPoolStream
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &streamA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &streamB)
+		return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+	case bytes.Equal(kvA.Key[:1], distribution.NextCommunityPoolStreamIDKey):
+		var idA, idB uint64
+		cdcA.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &idA)
+		cdcB.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idB)
+		return fmt.Sprintf("%v\n%v", idA, idB)
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
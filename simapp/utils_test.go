@@ -165,6 +165,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := distr.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := distr.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := distr.NewValidatorSlashEvent(10, sdk.OneDec())
+	missed := distr.ValidatorMissedRewards{decCoins[0]}
+	stream := distr.NewCommunityPoolStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 10, 20, 5)
 
 	kvPairs := cmn.KVPairs{
 		cmn.KVPair{Key: distr.FeePoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(feePool)},
@@ -176,6 +178,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		cmn.KVPair{Key: distr.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		cmn.KVPair{Key: distr.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
 		cmn.KVPair{Key: distr.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		cmn.KVPair{Key: distr.GetDelegatorAutoRestakeKey(delAddr1), Value: []byte{}},
+		cmn.KVPair{Key: distr.AutoRestakeCursorKey, Value: delAddr1.Bytes()},
+		cmn.KVPair{Key: distr.GetValidatorMissedRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(missed)},
+		cmn.KVPair{Key: distr.GetCommunityPoolStreamKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(stream)},
+		cmn.KVPair{Key: distr.NextCommunityPoolStreamIDKey, Value: cdc.MustMarshalBinaryLengthPrefixed(uint64(2))},
 		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -192,6 +199,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorAutoRestake", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"AutoRestakeCursor", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"ValidatorMissedRewards", fmt.Sprintf("%v\n%v", missed, missed)},
+		{"CommunityPoolStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextCommunityPoolStreamID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
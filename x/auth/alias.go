@@ -36,6 +36,7 @@ var (
 	ProcessPubKey                     = ante.ProcessPubKey
 	DefaultSigVerificationGasConsumer = ante.DefaultSigVerificationGasConsumer
 	DeductFees                        = ante.DeductFees
+	UseGrantedFees                    = ante.UseGrantedFees
 	EnsureSufficientMempoolFees       = ante.EnsureSufficientMempoolFees
 	SetGasMeter                       = ante.SetGasMeter
 	GetSignBytes                      = ante.GetSignBytes
@@ -50,6 +51,9 @@ var (
 	NewContinuousVestingAccount       = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw       = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount          = types.NewDelayedVestingAccount
+	NewPeriod                         = types.NewPeriod
+	NewPeriodicVestingAccountRaw      = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount         = types.NewPeriodicVestingAccount
 	NewAccountRetriever               = types.NewAccountRetriever
 	RegisterCodec                     = types.RegisterCodec
 	NewGenesisState                   = types.NewGenesisState
@@ -90,6 +94,9 @@ type (
 	BaseVestingAccount               = types.BaseVestingAccount
 	ContinuousVestingAccount         = types.ContinuousVestingAccount
 	DelayedVestingAccount            = types.DelayedVestingAccount
+	Period                           = types.Period
+	Periods                          = types.Periods
+	PeriodicVestingAccount           = types.PeriodicVestingAccount
 	NodeQuerier                      = types.NodeQuerier
 	AccountRetriever                 = types.AccountRetriever
 	GenesisState                     = types.GenesisState
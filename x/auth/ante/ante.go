@@ -36,8 +36,14 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+// signer. If the fee sets a fee granter, the fees are instead deducted from the
+// granter and charged against the allowance it has given the first signer
+// through the fee grant keeper. A nil fee grant keeper disables fee grants.
+func NewAnteHandler(
+	ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	sigGasConsumer SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -120,7 +126,17 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 
 		// deduct the fees
 		if !stdTx.Fee.Amount.IsZero() {
-			res = DeductFees(supplyKeeper, newCtx, signerAccs[0], stdTx.Fee.Amount)
+			feePayer := signerAccs[0]
+
+			// charge the fees to the fee granter's allowance if one is set
+			if granter := stdTx.Fee.FeeGranter; !granter.Empty() {
+				feePayer, res = UseGrantedFees(newCtx, ak, feeGrantKeeper, granter, signerAddrs[0], stdTx.Fee.Amount)
+				if !res.IsOK() {
+					return newCtx, res, true
+				}
+			}
+
+			res = DeductFees(supplyKeeper, newCtx, feePayer, stdTx.Fee.Amount)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -367,6 +383,25 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc exported.A
 	return sdk.Result{}
 }
 
+// UseGrantedFees charges the fees to the allowance the granter has given the
+// grantee and returns the granter's account, from which the fees are to be
+// deducted.
+func UseGrantedFees(
+	ctx sdk.Context, ak keeper.AccountKeeper, feeGrantKeeper types.FeeGrantKeeper,
+	granter, grantee sdk.AccAddress, fees sdk.Coins,
+) (exported.Account, sdk.Result) {
+
+	if feeGrantKeeper == nil {
+		return nil, sdk.ErrUnauthorized("fee grants are not supported").Result()
+	}
+
+	if err := feeGrantKeeper.UseGrantedFees(ctx, granter, grantee, fees); err != nil {
+		return nil, err.Result()
+	}
+
+	return GetSignerAcc(ctx, ak, granter)
+}
+
 // EnsureSufficientMempoolFees verifies that the given transaction has supplied
 // enough fees to cover a proposer's minimum fees. A result object is returned
 // indicating success or failure.
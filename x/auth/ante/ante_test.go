@@ -51,7 +51,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
 	ctx := input.Ctx
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -99,7 +99,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -156,7 +156,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(0)
 
 	// keys and addresses
@@ -212,7 +212,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -290,7 +290,7 @@ func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
 	ctx := input.Ctx
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -325,11 +325,76 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(sdk.IntEq(t, input.AccountKeeper.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), sdk.NewInt(0)))
 }
 
+// mockFeeGrantKeeper holds the fees each grantee may have paid by a granter
+type mockFeeGrantKeeper struct {
+	allowances map[string]sdk.Coins
+}
+
+func (k mockFeeGrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	key := granter.String() + grantee.String()
+	left, isNegative := k.allowances[key].SafeSub(fee)
+	if isNegative {
+		return sdk.ErrUnauthorized("fee allowance exceeded")
+	}
+
+	k.allowances[key] = left
+	return nil
+}
+
+// Test fees paid by a fee granter.
+func TestAnteHandlerFeeGranter(t *testing.T) {
+	// setup
+	input := keeper.SetupTestInput()
+	ctx := input.Ctx
+	feeGrantKeeper := mockFeeGrantKeeper{allowances: make(map[string]sdk.Coins)}
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, feeGrantKeeper, DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	_, _, addr2 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := input.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	input.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := input.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	input.AccountKeeper.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee().WithFeeGranter(addr2)
+	msgs := []sdk.Msg{msg}
+
+	// the granter has not given the signer an allowance
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// fee grants are rejected without a fee grant keeper
+	noGrantsAnteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
+	checkInvalidTx(t, noGrantsAnteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	feeGrantKeeper.allowances[addr2.String()+addr1.String()] = sdk.NewCoins(sdk.NewInt64Coin("atom", 200))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the fees are paid by the granter and charged against the allowance
+	require.True(sdk.IntEq(t, input.SupplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf("atom"), sdk.NewInt(150)))
+	require.True(sdk.IntEq(t, input.AccountKeeper.GetAccount(ctx, addr2).GetCoins().AmountOf("atom"), sdk.NewInt(0)))
+	require.True(t, input.AccountKeeper.GetAccount(ctx, addr1).GetCoins().Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50)), feeGrantKeeper.allowances[addr2.String()+addr1.String()])
+
+	// the allowance no longer covers the fee
+	seqs = []uint64{1}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -369,7 +434,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -419,7 +484,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -496,7 +561,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -692,7 +757,7 @@ func TestCountSubkeys(t *testing.T) {
 func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.Ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -782,7 +847,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// setup
 	input := keeper.SetupTestInput()
 	// setup an ante handler that only accepts PubKeyEd25519
-	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) sdk.Result {
+	anteHandler := NewAnteHandler(input.AccountKeeper, input.SupplyKeeper, nil, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) sdk.Result {
 		switch pubkey := pubkey.(type) {
 		case ed25519.PubKeyEd25519:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto"
@@ -535,3 +536,207 @@ func (dva *DelayedVestingAccount) GetStartTime() int64 {
 func (dva *DelayedVestingAccount) GetEndTime() int64 {
 	return dva.EndTime
 }
+
+//-----------------------------------------------------------------------------
+// Periodic Vesting Account
+
+var _ exported.VestingAccount = (*PeriodicVestingAccount)(nil)
+
+// Period defines a length of time and amount of coins that will vest at the end
+// of that length of time.
+type Period struct {
+	Length int64     `json:"length" yaml:"length"` // length of the period, in seconds
+	Amount sdk.Coins `json:"amount" yaml:"amount"` // amount of coins vesting during this period
+}
+
+// NewPeriod returns a new vesting Period
+func NewPeriod(length int64, amount sdk.Coins) Period {
+	return Period{
+		Length: length,
+		Amount: amount,
+	}
+}
+
+// String implements fmt.Stringer
+func (p Period) String() string {
+	return fmt.Sprintf(`Length: %d
+Amount: %s`, p.Length, p.Amount)
+}
+
+// Periods defines a sequence of vesting periods
+type Periods []Period
+
+// TotalLength returns the total length in seconds of a set of periods
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the coins vesting in a set of periods
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// Validate checks that every period has a positive length and a valid,
+// non-zero amount of coins.
+func (vp Periods) Validate() error {
+	for i, period := range vp {
+		if period.Length <= 0 {
+			return fmt.Errorf("vesting period #%d has a non-positive length: %d", i, period.Length)
+		}
+		if !period.Amount.IsValid() || period.Amount.IsZero() {
+			return fmt.Errorf("vesting period #%d has an invalid amount: %s", i, period.Amount)
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (vp Periods) String() string {
+	periodsListString := make([]string, len(vp))
+	for i, period := range vp {
+		periodsListString[i] = period.String()
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
+%s`, strings.Join(periodsListString, ",\n")))
+}
+
+// PeriodicVestingAccount implements the VestingAccount interface. It vests coins
+// according to a sequence of vesting periods starting at a given start time.
+// The coins of each period are unlocked at once at the end of the period.
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime      int64   `json:"start_time"`      // when the coins start to vest
+	VestingPeriods Periods `json:"vesting_periods"` // the vesting schedule
+}
+
+// NewPeriodicVestingAccountRaw creates a new PeriodicVestingAccount object from BaseVestingAccount
+func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount,
+	startTime int64, periods Periods) *PeriodicVestingAccount {
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// NewPeriodicVestingAccount returns a new PeriodicVestingAccount. The original
+// vesting amount is the sum of all the periods and the end time is the start
+// time plus the total length of the periods.
+func NewPeriodicVestingAccount(
+	baseAcc *BaseAccount, startTime int64, periods Periods,
+) *PeriodicVestingAccount {
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: periods.TotalAmount(),
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	var pubkey string
+
+	if pva.PubKey != nil {
+		pubkey = sdk.MustBech32ifyAccPub(pva.PubKey)
+	}
+
+	return fmt.Sprintf(`Periodic Vesting Account:
+  Address:          %s
+  Pubkey:           %s
+  Coins:            %s
+  AccountNumber:    %d
+  Sequence:         %d
+  OriginalVesting:  %s
+  DelegatedFree:    %s
+  DelegatedVesting: %s
+  StartTime:        %d
+  EndTime:          %d
+  VestingPeriods:   %d `,
+		pva.Address, pubkey, pva.Coins, pva.AccountNumber, pva.Sequence,
+		pva.OriginalVesting, pva.DelegatedFree, pva.DelegatedVesting,
+		pva.StartTime, pva.EndTime, len(pva.VestingPeriods),
+	)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	// We must handle the case where the start time for a vesting account has
+	// been set into the future or when the start of the chain is not exactly
+	// known.
+	if blockTime.Unix() <= pva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount)
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// SpendableCoins returns the total number of spendable coins per denom for a
+// periodic vesting account.
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a periodic vesting
+// account.
+func (pva *PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// GetEndTime returns the time when vesting ends for a periodic vesting account.
+func (pva *PeriodicVestingAccount) GetEndTime() int64 {
+	return pva.EndTime
+}
+
+// GetVestingPeriods returns the vesting schedule of a periodic vesting account.
+func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
+	return pva.VestingPeriods
+}
@@ -11,3 +11,9 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeeGrantKeeper defines the expected fee grant Keeper used by the ante handler
+// to pay fees on behalf of another account (noalias)
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+}
@@ -111,10 +111,13 @@ func (tx StdTx) GetSignatures() []StdSignature { return tx.Signatures }
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. If a fee
+// granter is set, the fees are paid by the granter out of a fee allowance it
+// has given the first signer instead of by the first signer itself.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount     sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas        uint64         `json:"gas" yaml:"gas"`
+	FeeGranter sdk.AccAddress `json:"fee_granter,omitempty" yaml:"fee_granter,omitempty"`
 }
 
 // NewStdFee returns a new instance of StdFee
@@ -125,6 +128,12 @@ func NewStdFee(gas uint64, amount sdk.Coins) StdFee {
 	}
 }
 
+// WithFeeGranter returns a copy of the fee with the given fee granter.
+func (fee StdFee) WithFeeGranter(granter sdk.AccAddress) StdFee {
+	fee.FeeGranter = granter
+	return fee
+}
+
 // Bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
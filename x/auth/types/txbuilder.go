@@ -26,6 +26,7 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -70,6 +71,10 @@ func NewTxBuilderFromCLI() TxBuilder {
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if feeGranter := flags.FeeGranterFlagVar.Granter; !feeGranter.Empty() {
+		txbldr = txbldr.WithFeeGranter(feeGranter)
+	}
+
 	return txbldr
 }
 
@@ -107,6 +112,9 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeeGranter returns the account paying the fees for the transaction, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -147,6 +155,12 @@ func (bldr TxBuilder) WithGasPrices(gasPrices string) TxBuilder {
 	return bldr
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(feeGranter sdk.AccAddress) TxBuilder {
+	bldr.feeGranter = feeGranter
+	return bldr
+}
+
 // WithKeybase returns a copy of the context with updated keybase.
 func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	bldr.keybase = keybase
@@ -202,7 +216,7 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		Sequence:      bldr.sequence,
 		Memo:          bldr.memo,
 		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		Fee:           NewStdFee(bldr.gas, fees).WithFeeGranter(bldr.feeGranter),
 	}, nil
 }
 
@@ -0,0 +1,83 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/authz/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/authz/internal/types
+package authz
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+const (
+	DefaultCodespace             = types.DefaultCodespace
+	CodeAuthorizationNotFound    = types.CodeAuthorizationNotFound
+	CodeInvalidAuthorization     = types.CodeInvalidAuthorization
+	CodeInvalidExpiration        = types.CodeInvalidExpiration
+	CodeUnauthorizedMsg          = types.CodeUnauthorizedMsg
+	CodeInvalidExecMsg           = types.CodeInvalidExecMsg
+	EventTypeGrantAuthorization  = types.EventTypeGrantAuthorization
+	EventTypeRevokeAuthorization = types.EventTypeRevokeAuthorization
+	EventTypeExecAuthorized      = types.EventTypeExecAuthorized
+	AttributeValueCategory       = types.AttributeValueCategory
+	AttributeKeyGranter          = types.AttributeKeyGranter
+	AttributeKeyGrantee          = types.AttributeKeyGrantee
+	AttributeKeyMsgType          = types.AttributeKeyMsgType
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	TypeMsgGrantAuthorization    = types.TypeMsgGrantAuthorization
+	TypeMsgRevokeAuthorization   = types.TypeMsgRevokeAuthorization
+	TypeMsgExecAuthorized        = types.TypeMsgExecAuthorized
+	QueryAuthorization           = types.QueryAuthorization
+	QueryAuthorizations          = types.QueryAuthorizations
+)
+
+var (
+	// functions aliases
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	MsgType                        = types.MsgType
+	NewSendAuthorization           = types.NewSendAuthorization
+	NewGenericAuthorization        = types.NewGenericAuthorization
+	NewAuthorizationGrant          = types.NewAuthorizationGrant
+	NewGrantAuthorization          = types.NewGrantAuthorization
+	RegisterCodec                  = types.RegisterCodec
+	RegisterAuthorizationTypeCodec = types.RegisterAuthorizationTypeCodec
+	ErrAuthorizationNotFound       = types.ErrAuthorizationNotFound
+	ErrInvalidAuthorization        = types.ErrInvalidAuthorization
+	ErrInvalidExpiration           = types.ErrInvalidExpiration
+	ErrUnauthorizedMsg             = types.ErrUnauthorizedMsg
+	ErrInvalidExecMsg              = types.ErrInvalidExecMsg
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	GetGrantsKey                   = types.GetGrantsKey
+	GetGrantKey                    = types.GetGrantKey
+	SplitGrantKey                  = types.SplitGrantKey
+	NewMsgGrantAuthorization       = types.NewMsgGrantAuthorization
+	NewMsgRevokeAuthorization      = types.NewMsgRevokeAuthorization
+	NewMsgExecAuthorized           = types.NewMsgExecAuthorized
+	NewQueryAuthorizationParams    = types.NewQueryAuthorizationParams
+	NewQueryAuthorizationsParams   = types.NewQueryAuthorizationsParams
+
+	// variable aliases
+	ModuleCdc      = types.ModuleCdc
+	GrantKeyPrefix = types.GrantKeyPrefix
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	SendAuthorization         = types.SendAuthorization
+	GenericAuthorization      = types.GenericAuthorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	GrantAuthorization        = types.GrantAuthorization
+	GrantAuthorizations       = types.GrantAuthorizations
+	GenesisState              = types.GenesisState
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExecAuthorized         = types.MsgExecAuthorized
+	QueryAuthorizationParams  = types.QueryAuthorizationParams
+	QueryAuthorizationsParams = types.QueryAuthorizationsParams
+)
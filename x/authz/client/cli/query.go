@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the authz module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryAuthorization(cdc),
+			GetCmdQueryAuthorizations(cdc),
+		)...,
+	)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryAuthorization implements the query authorization command.
+func GetCmdQueryAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-type]",
+		Short: "Query the authorization given by a granter to a grantee for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization given by a granter to a grantee for a message type,
+in the form <route>/<type>.
+
+Example:
+$ %s query %s authorization cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p bank/send
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, args[2]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorization)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.GrantAuthorization
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryAuthorizations implements the query authorizations command.
+func GetCmdQueryAuthorizations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Short: "Query all the authorizations given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the unexpired authorizations given by a granter to a grantee.
+
+Example:
+$ %s query %s authorizations cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants types.GrantAuthorizations
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+// Authorization types accepted by the grant command
+const (
+	AuthorizationTypeSend    = "send"
+	AuthorizationTypeGeneric = "generic"
+)
+
+const (
+	flagExpiration = "expiration"
+)
+
+// GetTxCmd returns the transaction commands for the authz module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantAuthorization(cdc),
+		GetCmdRevokeAuthorization(cdc),
+		GetCmdExecAuthorized(cdc),
+	)...)
+
+	return authzTxCmd
+}
+
+// GetCmdGrantAuthorization implements the grant authorization command.
+func GetCmdGrantAuthorization(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [authorization-type] [value]",
+		Short: "Grant an account the authorization to execute messages on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the grantee the authorization to execute messages on behalf of the
+sender. The authorization type is one of:
+
+  %s     allow bank sends up to the spend limit given as value
+  %s  allow any message of the type given as value, in the form <route>/<type>
+
+The authorization does not expire unless an --expiration (RFC3339) is given.
+
+Example:
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p send 1000stake --from mykey
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p generic distribution/withdraw_delegator_reward --expiration 2021-01-01T00:00:00Z --from mykey
+`,
+				AuthorizationTypeSend, AuthorizationTypeGeneric,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization exported.Authorization
+			switch args[1] {
+			case AuthorizationTypeSend:
+				spendLimit, err := sdk.ParseCoins(args[2])
+				if err != nil {
+					return err
+				}
+				authorization = types.NewSendAuthorization(spendLimit)
+
+			case AuthorizationTypeGeneric:
+				authorization = types.NewGenericAuthorization(args[2])
+
+			default:
+				return fmt.Errorf("invalid authorization type %s, expected %s or %s",
+					args[1], AuthorizationTypeSend, AuthorizationTypeGeneric)
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(flagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgGrantAuthorization(cliCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagExpiration, "", "The time (RFC3339) at which the authorization expires")
+
+	return cmd
+}
+
+// GetCmdRevokeAuthorization implements the revoke authorization command.
+func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke an authorization given to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization given by the sender to the grantee for the message
+type, in the form <route>/<type>.
+
+Example:
+$ %s tx %s revoke cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p bank/send --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(cliCtx.GetFromAddress(), grantee, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdExecAuthorized implements the exec authorized command.
+func GetCmdExecAuthorized(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exec [tx-json-file]",
+		Short: "Execute the messages of a transaction on behalf of their granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of an unsigned transaction, generated by the granter
+with --generate-only, on behalf of the granter. The sender must have been
+granted an authorization for each of the messages.
+
+Example:
+$ %s tx bank send granter cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 100stake --generate-only > tx.json
+$ %s tx %s exec tx.json --from mykey
+`,
+				version.ClientName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecAuthorized(cliCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		fmt.Sprintf("/authz/granters/{%s}/grantees/{%s}/grants/{%s}/{%s}",
+			RestParamGranter, RestParamGrantee, RestParamMsgRoute, RestParamMsgType),
+		queryAuthorizationHandler(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/authz/granters/{%s}/grantees/{%s}/grants", RestParamGranter, RestParamGrantee),
+		queryAuthorizationsHandler(cliCtx),
+	).Methods("GET")
+}
+
+func queryAuthorizationHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, grantee, ok := parseGranterAndGrantee(w, vars)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		msgType := fmt.Sprintf("%s/%s", vars[RestParamMsgRoute], vars[RestParamMsgType])
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuthorizationParams(granter, grantee, msgType))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorization)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryAuthorizationsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		granter, grantee, ok := parseGranterAndGrantee(w, mux.Vars(r))
+		if !ok {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAuthorizationsParams(granter, grantee))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuthorizations)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func parseGranterAndGrantee(w http.ResponseWriter, vars map[string]string) (granter, grantee sdk.AccAddress, ok bool) {
+	granter, err := sdk.AccAddressFromBech32(vars[RestParamGranter])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	grantee, err = sdk.AccAddressFromBech32(vars[RestParamGrantee])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	return granter, grantee, true
+}
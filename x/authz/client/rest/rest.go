@@ -0,0 +1,21 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+)
+
+// REST query and parameter values
+const (
+	RestParamGranter  = "granter"
+	RestParamGrantee  = "grantee"
+	RestParamMsgRoute = "msg-route"
+	RestParamMsgType  = "msg-type"
+)
+
+// RegisterRoutes registers the authz module's REST service handlers.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
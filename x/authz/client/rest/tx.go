@@ -0,0 +1,129 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/authz/grants", grantAuthorizationHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/revoke", revokeAuthorizationHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/authz/exec", execAuthorizedHandlerFn(cliCtx)).Methods("POST")
+}
+
+// GrantAuthorizationReq defines the properties of a grant authorization
+// request's body. The granter is the sender of the base request.
+type GrantAuthorizationReq struct {
+	BaseReq       rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// RevokeAuthorizationReq defines the properties of a revoke authorization
+// request's body. The granter is the sender of the base request.
+type RevokeAuthorizationReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// ExecAuthorizedReq defines the properties of an exec authorized request's
+// body. The grantee is the sender of the base request.
+type ExecAuthorizedReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Msgs    []sdk.Msg    `json:"msgs" yaml:"msgs"`
+}
+
+func grantAuthorizationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantAuthorizationReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgGrantAuthorization(granter, req.Grantee, req.Authorization, req.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeAuthorizationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeAuthorizationReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeAuthorization(granter, req.Grantee, req.MsgType)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func execAuthorizedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecAuthorizedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgExecAuthorized(grantee, req.Msgs)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package authz implements generic authorizations which allow one account (the
+granter) to let another account (the grantee) execute messages on its behalf.
+
+An authorization is any type implementing the exported.Authorization interface
+and applies to a single message type, identified as "<route>/<type>". The
+module provides a GenericAuthorization, which accepts any message of its type,
+and a SendAuthorization, which accepts bank sends up to a spend limit that is
+lowered with each send. Modules may define their own authorizations and
+register them with RegisterAuthorizationTypeCodec.
+
+A granter gives an authorization with a MsgGrantAuthorization, optionally
+with an expiration, and removes it with a MsgRevokeAuthorization. The grantee
+executes messages with a MsgExecAuthorized, which wraps the messages to
+execute. Each wrapped message must have a single signer. Unless that signer is
+the grantee itself, it is taken as the granter and must have given the grantee
+an unexpired authorization that accepts the message. The message is then
+dispatched through the application's message router to its module's handler
+as if it had been signed by the granter.
+*/
+package authz
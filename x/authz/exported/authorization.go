@@ -0,0 +1,24 @@
+package exported
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Authorization represents a permission granted by a granter to a grantee to
+// execute a single type of message on the granter's behalf.
+type Authorization interface {
+	// MsgType returns the type of message this authorization applies to, in the
+	// form "<route>/<type>".
+	MsgType() string
+
+	// Accept determines whether the grantee may execute the given message. If
+	// allowed, it may return an updated authorization to replace the stored one
+	// (e.g. with a lowered spend limit) or request that the authorization be
+	// deleted because it has been used up.
+	Accept(msg sdk.Msg, block abci.Header) (allow bool, updated Authorization, delete bool)
+
+	ValidateBasic() sdk.Error
+	String() string
+}
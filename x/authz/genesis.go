@@ -0,0 +1,30 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// InitGenesis initializes the authz module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, ga := range gs.Authorizations {
+		k.SetGrant(ctx, ga.Granter, ga.Grantee, NewAuthorizationGrant(ga.Authorization, ga.Expiration))
+	}
+}
+
+// ExportGenesis returns the authz module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	authorizations := []GrantAuthorization{}
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant AuthorizationGrant) bool {
+		authorizations = append(authorizations, NewGrantAuthorization(granter, grantee, grant))
+		return false
+	})
+
+	return NewGenesisState(authorizations)
+}
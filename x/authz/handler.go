@@ -0,0 +1,91 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// NewHandler returns a handler for authz messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, k, msg)
+
+		case MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, k, msg)
+
+		case MsgExecAuthorized:
+			return handleMsgExecAuthorized(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantAuthorization(ctx sdk.Context, k Keeper, msg MsgGrantAuthorization) sdk.Result {
+	err := k.Grant(ctx, msg.Granter, msg.Grantee, msg.Authorization, msg.Expiration)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeGrantAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgType, msg.Authorization.MsgType()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeAuthorization(ctx sdk.Context, k Keeper, msg MsgRevokeAuthorization) sdk.Result {
+	err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.AuthorizationMsgType)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeRevokeAuthorization,
+			sdk.NewAttribute(AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(AttributeKeyMsgType, msg.AuthorizationMsgType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExecAuthorized(ctx sdk.Context, k Keeper, msg MsgExecAuthorized) sdk.Result {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeExecAuthorized,
+			sdk.NewAttribute(AttributeKeyGrantee, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Grantee.String()),
+		),
+	})
+
+	return k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+// Keeper defines the authz module's keeper. The keeper manages the
+// authorizations given by granters to grantees and dispatches the messages
+// grantees execute on behalf of granters.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	router    sdk.Router
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new authz Keeper instance. The router is used to
+// dispatch the messages executed through a MsgExecAuthorized and is expected
+// to be the application's message router.
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the authz module's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Grant gives the grantee the authorization to execute messages of the
+// authorization's type on behalf of the granter, replacing any existing
+// authorization for that type. A zero expiration never expires.
+func (k Keeper) Grant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) sdk.Error {
+
+	if !expiration.IsZero() && !expiration.After(ctx.BlockHeader().Time) {
+		return types.ErrInvalidExpiration(k.codespace, expiration.String())
+	}
+
+	k.SetGrant(ctx, granter, grantee, types.NewAuthorizationGrant(authorization, expiration))
+	return nil
+}
+
+// Revoke removes the authorization given by the granter to the grantee for the
+// given message type.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	if _, found := k.GetGrant(ctx, granter, grantee, msgType); !found {
+		return types.ErrAuthorizationNotFound(k.codespace, granter, grantee, msgType)
+	}
+
+	k.DeleteGrant(ctx, granter, grantee, msgType)
+	return nil
+}
+
+// GetAuthorization returns the unexpired authorization given by the granter to
+// the grantee for the given message type, if any.
+func (k Keeper) GetAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (exported.Authorization, bool) {
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return nil, false
+	}
+
+	return grant.Authorization, true
+}
+
+// DispatchActions executes the given messages on behalf of their signers. A
+// message whose signer is not the grantee is only executed if the signer has
+// given the grantee an authorization accepting it. Each message is routed to
+// its module's handler as if it had been signed by the granter.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return types.ErrInvalidExecMsg(k.codespace, "messages must have exactly one signer").Result()
+		}
+
+		granter := signers[0]
+
+		// a grantee does not need an authorization to execute its own messages
+		if !granter.Equals(grantee) {
+			if err := k.acceptMsg(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized message route: %s", msg.Route())).Result()
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		data = append(data, res.Data...)
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	return sdk.Result{
+		Data:   data,
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// acceptMsg checks that the granter has given the grantee an authorization
+// accepting the message and updates or deletes the stored authorization as
+// requested by it.
+func (k Keeper) acceptMsg(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgType := types.MsgType(msg)
+
+	grant, found := k.GetGrant(ctx, granter, grantee, msgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrAuthorizationNotFound(k.codespace, granter, grantee, msgType)
+	}
+
+	allow, updated, del := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if !allow {
+		return types.ErrUnauthorizedMsg(k.codespace, msgType)
+	}
+
+	switch {
+	case del:
+		k.DeleteGrant(ctx, granter, grantee, msgType)
+	case updated != nil:
+		k.SetGrant(ctx, granter, grantee, types.NewAuthorizationGrant(updated, grant.Expiration))
+	}
+
+	return nil
+}
+
+// SetGrant stores the authorization grant given by the granter to the grantee
+// under the authorization's message type.
+func (k Keeper) SetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GetGrantKey(granter, grantee, grant.Authorization.MsgType()), bz)
+}
+
+// GetGrant returns the authorization grant given by the granter to the grantee
+// for the given message type regardless of its expiration.
+func (k Keeper) GetGrant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (grant types.AuthorizationGrant, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetGrantKey(granter, grantee, msgType))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// DeleteGrant removes the authorization grant given by the granter to the
+// grantee for the given message type.
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGrantKey(granter, grantee, msgType))
+}
+
+// GetGrants returns all the unexpired authorizations given by the granter to
+// the grantee.
+func (k Keeper) GetGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) (grants []types.GrantAuthorization) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetGrantsKey(granter, grantee))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+
+		if grant.IsExpired(ctx.BlockHeader().Time) {
+			continue
+		}
+
+		grants = append(grants, types.NewGrantAuthorization(granter, grantee, grant))
+	}
+
+	return grants
+}
+
+// IterateGrants iterates over all the stored authorization grants. For each
+// grant, cb will be called. If the cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateGrants(
+	ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GrantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+
+		granter, grantee, _ := types.SplitGrantKey(iterator.Key())
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/baseapp"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+)
+
+var (
+	granterAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granteeAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+// mockBankHandler records the bank messages it is asked to handle
+type mockBankHandler struct {
+	msgs []sdk.Msg
+}
+
+func (h *mockBankHandler) handle(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+	h.msgs = append(h.msgs, msg)
+	return sdk.Result{Data: []byte{byte(len(h.msgs))}}
+}
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, *mockBankHandler) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	bankHandler := &mockBankHandler{}
+	router := baseapp.NewRouter()
+	router.AddRoute(bank.RouterKey, bankHandler.handle)
+
+	k := NewKeeper(cdc, key, router, types.DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Now().UTC()}, false, log.NewNopLogger())
+	return ctx, k, bankHandler
+}
+
+func newMsgSend(from, to sdk.AccAddress, amount sdk.Coins) bank.MsgSend {
+	return bank.MsgSend{FromAddress: from, ToAddress: to, Amount: amount}
+}
+
+func TestGrantAndRevoke(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	_, found := k.GetAuthorization(ctx, granterAddr, granteeAddr, "bank/send")
+	require.False(t, found)
+
+	// expiration must be in the future
+	require.Error(t, k.Grant(ctx, granterAddr, granteeAddr, authorization, now))
+
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, authorization, now.Add(time.Hour)))
+	got, found := k.GetAuthorization(ctx, granterAddr, granteeAddr, "bank/send")
+	require.True(t, found)
+	require.Equal(t, authorization, got)
+
+	// authorizations are directional
+	_, found = k.GetAuthorization(ctx, granteeAddr, granterAddr, "bank/send")
+	require.False(t, found)
+
+	// expired authorizations are not returned
+	_, found = k.GetAuthorization(ctx.WithBlockTime(now.Add(time.Hour)), granterAddr, granteeAddr, "bank/send")
+	require.False(t, found)
+
+	// a new grant replaces the existing one
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, authorization, time.Time{}))
+	_, found = k.GetAuthorization(ctx.WithBlockTime(now.Add(time.Hour)), granterAddr, granteeAddr, "bank/send")
+	require.True(t, found)
+
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, types.NewGenericAuthorization("staking/delegate"), time.Time{}))
+	require.Len(t, k.GetGrants(ctx, granterAddr, granteeAddr), 2)
+	require.Len(t, k.GetGrants(ctx, granterAddr, otherAddr), 0)
+
+	require.NoError(t, k.Revoke(ctx, granterAddr, granteeAddr, "bank/send"))
+	_, found = k.GetAuthorization(ctx, granterAddr, granteeAddr, "bank/send")
+	require.False(t, found)
+	require.Len(t, k.GetGrants(ctx, granterAddr, granteeAddr), 1)
+
+	// revoking a missing authorization fails
+	require.Error(t, k.Revoke(ctx, granterAddr, granteeAddr, "bank/send"))
+}
+
+func TestDispatchActions(t *testing.T) {
+	ctx, k, bankHandler := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+
+	msg := newMsgSend(granterAddr, otherAddr, sendCoins)
+
+	// no authorization
+	res := k.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeAuthorizationNotFound, res.Code)
+	require.Empty(t, bankHandler.msgs)
+
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, types.NewSendAuthorization(spendLimit), now.Add(time.Hour)))
+
+	// expired authorization
+	res = k.DispatchActions(ctx.WithBlockTime(now.Add(2*time.Hour)), granteeAddr, []sdk.Msg{msg})
+	require.False(t, res.IsOK())
+	require.Empty(t, bankHandler.msgs)
+
+	// the spend limit is lowered by each send
+	res = k.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []sdk.Msg{msg}, bankHandler.msgs)
+
+	authorization, found := k.GetAuthorization(ctx, granterAddr, granteeAddr, "bank/send")
+	require.True(t, found)
+	require.Equal(t, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 40))), authorization)
+
+	// sends exceeding the remaining limit are rejected
+	res = k.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeUnauthorizedMsg, res.Code)
+	require.Len(t, bankHandler.msgs, 1)
+
+	// exhausting the limit deletes the authorization
+	msg = newMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	res = k.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	require.True(t, res.IsOK(), res.Log)
+	require.Len(t, bankHandler.msgs, 2)
+
+	_, found = k.GetAuthorization(ctx, granterAddr, granteeAddr, "bank/send")
+	require.False(t, found)
+
+	// the grantee may execute its own messages without an authorization
+	msg = newMsgSend(granteeAddr, otherAddr, sendCoins)
+	res = k.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	require.True(t, res.IsOK(), res.Log)
+	require.Len(t, bankHandler.msgs, 3)
+
+	// messages without a registered route are rejected
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, types.NewGenericAuthorization(types.MsgType(types.MsgRevokeAuthorization{})), time.Time{}))
+	res = k.DispatchActions(ctx, granteeAddr, []sdk.Msg{types.NewMsgRevokeAuthorization(granterAddr, otherAddr, "bank/send")})
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.CodeUnknownRequest, res.Code)
+}
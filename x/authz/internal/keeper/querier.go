@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+// NewQuerier creates a querier for authz cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryAuthorization:
+			return queryAuthorization(ctx, req, k)
+
+		case types.QueryAuthorizations:
+			return queryAuthorizations(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown authz query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryAuthorization(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grant, found := k.GetGrant(ctx, params.Granter, params.Grantee, params.MsgType)
+	if !found || grant.IsExpired(ctx.BlockHeader().Time) {
+		return nil, types.ErrAuthorizationNotFound(k.codespace, params.Granter, params.Grantee, params.MsgType)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, types.NewGrantAuthorization(params.Granter, params.Grantee, grant))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAuthorizations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAuthorizationsParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := k.GetGrants(ctx, params.Granter, params.Grantee)
+	if grants == nil {
+		grants = []types.GrantAuthorization{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/internal/types"
+)
+
+func TestQueryAuthorizations(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	querier := NewQuerier(k)
+
+	sendAuthorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	genericAuthorization := types.NewGenericAuthorization("staking/delegate")
+	expiration := ctx.BlockHeader().Time.Add(time.Hour).UTC()
+
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, sendAuthorization, expiration))
+	require.NoError(t, k.Grant(ctx, granterAddr, granteeAddr, genericAuthorization, time.Time{}))
+
+	req := abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granterAddr, granteeAddr, "bank/send")),
+	}
+	bz, err := querier(ctx, []string{types.QueryAuthorization}, req)
+	require.NoError(t, err)
+
+	var grant types.GrantAuthorization
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &grant))
+	require.Equal(t, granterAddr, grant.Granter)
+	require.Equal(t, granteeAddr, grant.Grantee)
+	require.Equal(t, sendAuthorization, grant.Authorization)
+	require.True(t, expiration.Equal(grant.Expiration))
+
+	req = abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryAuthorizationParams(granteeAddr, granterAddr, "bank/send")),
+	}
+	_, err = querier(ctx, []string{types.QueryAuthorization}, req)
+	require.Error(t, err)
+
+	req = abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryAuthorizationsParams(granterAddr, granteeAddr)),
+	}
+	bz, err = querier(ctx, []string{types.QueryAuthorizations}, req)
+	require.NoError(t, err)
+
+	var grants []types.GrantAuthorization
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &grants))
+	require.Len(t, grants, 2)
+
+	_, err = querier(ctx, []string{"unknown"}, req)
+	require.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+)
+
+// MsgType returns the identifier of the given message's type, in the form
+// "<route>/<type>", under which authorizations for it are stored.
+func MsgType(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+var (
+	_ exported.Authorization = SendAuthorization{}
+	_ exported.Authorization = GenericAuthorization{}
+)
+
+// SendAuthorization allows the grantee to send up to SpendLimit coins from the
+// granter's account through a bank MsgSend.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) SendAuthorization {
+	return SendAuthorization{SpendLimit: spendLimit}
+}
+
+// MsgType implements the Authorization interface.
+func (a SendAuthorization) MsgType() string {
+	return MsgType(bank.MsgSend{})
+}
+
+// Accept implements the Authorization interface. The sent amount is deducted
+// from the spend limit and the authorization is deleted once the limit is
+// exhausted.
+func (a SendAuthorization) Accept(msg sdk.Msg, _ abci.Header) (bool, exported.Authorization, bool) {
+	msgSend, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, nil, false
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return false, nil, false
+	}
+	if limitLeft.IsZero() {
+		return true, nil, true
+	}
+
+	return true, SendAuthorization{SpendLimit: limitLeft}, false
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	return nil
+}
+
+// String implements the Authorization interface.
+func (a SendAuthorization) String() string {
+	return fmt.Sprintf(`Send Authorization:
+  Spend Limit: %s`, a.SpendLimit)
+}
+
+// GenericAuthorization allows the grantee to execute any message of the given
+// type on behalf of the granter without further restrictions.
+type GenericAuthorization struct {
+	Msg string `json:"msg" yaml:"msg"`
+}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgType string) GenericAuthorization {
+	return GenericAuthorization{Msg: msgType}
+}
+
+// MsgType implements the Authorization interface.
+func (a GenericAuthorization) MsgType() string {
+	return a.Msg
+}
+
+// Accept implements the Authorization interface.
+func (a GenericAuthorization) Accept(msg sdk.Msg, _ abci.Header) (bool, exported.Authorization, bool) {
+	return MsgType(msg) == a.Msg, nil, false
+}
+
+// ValidateBasic implements the Authorization interface.
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	parts := strings.Split(a.Msg, "/")
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, fmt.Sprintf("invalid message type %q, expected <route>/<type>", a.Msg))
+	}
+	return nil
+}
+
+// String implements the Authorization interface.
+func (a GenericAuthorization) String() string {
+	return fmt.Sprintf(`Generic Authorization:
+  Msg: %s`, a.Msg)
+}
+
+// AuthorizationGrant is an authorization together with its expiration as
+// persisted by the keeper. A zero expiration means the grant never expires.
+type AuthorizationGrant struct {
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewAuthorizationGrant creates a new AuthorizationGrant object.
+func NewAuthorizationGrant(authorization exported.Authorization, expiration time.Time) AuthorizationGrant {
+	return AuthorizationGrant{Authorization: authorization, Expiration: expiration}
+}
+
+// IsExpired returns true if the grant has an expiration which is at or before
+// the given time.
+func (g AuthorizationGrant) IsExpired(t time.Time) bool {
+	return !g.Expiration.IsZero() && !g.Expiration.After(t)
+}
+
+// GrantAuthorization defines an authorization along with its granter and
+// grantee, as used in genesis and query responses.
+type GrantAuthorization struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewGrantAuthorization creates a new GrantAuthorization object.
+func NewGrantAuthorization(granter, grantee sdk.AccAddress, grant AuthorizationGrant) GrantAuthorization {
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: grant.Authorization,
+		Expiration:    grant.Expiration,
+	}
+}
+
+// String implements the Stringer interface.
+func (ga GrantAuthorization) String() string {
+	return fmt.Sprintf(`Granter:    %s
+Grantee:    %s
+Expiration: %s
+%s`, ga.Granter, ga.Grantee, ga.Expiration, ga.Authorization)
+}
+
+// GrantAuthorizations defines a list of GrantAuthorization objects.
+type GrantAuthorizations []GrantAuthorization
+
+// String implements the Stringer interface.
+func (gas GrantAuthorizations) String() (out string) {
+	for _, ga := range gas {
+		out += ga.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+)
+
+func newMsgSend(from, to sdk.AccAddress, amount sdk.Coins) bank.MsgSend {
+	return bank.MsgSend{FromAddress: from, ToAddress: to, Amount: amount}
+}
+
+func TestSendAuthorization(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	header := abci.Header{Time: time.Now()}
+
+	authorization := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.Equal(t, "bank/send", authorization.MsgType())
+	require.NoError(t, authorization.ValidateBasic())
+
+	// spending part of the limit lowers it
+	msg := newMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	allow, updated, del := authorization.Accept(msg, header)
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), updated)
+
+	// spending more than the limit is rejected
+	msg = newMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 101)))
+	allow, _, _ = authorization.Accept(msg, header)
+	require.False(t, allow)
+
+	// spending another denom is rejected
+	msg = newMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+	allow, _, _ = authorization.Accept(msg, header)
+	require.False(t, allow)
+
+	// spending the whole limit deletes the authorization
+	msg = newMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	allow, _, del = authorization.Accept(msg, header)
+	require.True(t, allow)
+	require.True(t, del)
+
+	// other messages are rejected
+	allow, _, _ = authorization.Accept(NewMsgRevokeAuthorization(addr1, addr2, "bank/send"), header)
+	require.False(t, allow)
+
+	require.Error(t, NewSendAuthorization(sdk.Coins{}).ValidateBasic())
+	require.Error(t, NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	header := abci.Header{Time: time.Now()}
+
+	authorization := NewGenericAuthorization("bank/send")
+	require.Equal(t, "bank/send", authorization.MsgType())
+	require.NoError(t, authorization.ValidateBasic())
+
+	msg := newMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	allow, updated, del := authorization.Accept(msg, header)
+	require.True(t, allow)
+	require.Nil(t, updated)
+	require.False(t, del)
+
+	allow, _, _ = authorization.Accept(NewMsgRevokeAuthorization(addr1, addr2, "bank/send"), header)
+	require.False(t, allow)
+
+	require.Error(t, NewGenericAuthorization("").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("send").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank/").ValidateBasic())
+	require.Error(t, NewGenericAuthorization("bank/send/extra").ValidateBasic())
+}
+
+func TestAuthorizationGrantIsExpired(t *testing.T) {
+	now := time.Now()
+	authorization := NewGenericAuthorization("bank/send")
+
+	require.False(t, NewAuthorizationGrant(authorization, time.Time{}).IsExpired(now))
+	require.False(t, NewAuthorizationGrant(authorization, now.Add(time.Hour)).IsExpired(now))
+	require.True(t, NewAuthorizationGrant(authorization, now).IsExpired(now))
+	require.True(t, NewAuthorizationGrant(authorization, now.Add(-time.Hour)).IsExpired(now))
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+)
+
+// ModuleCdc defines the authz module's codec. The codec is not sealed as to
+// allow other modules to register their concrete Authorization types.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// authz module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*exported.Authorization)(nil), nil)
+	cdc.RegisterConcrete(MsgGrantAuthorization{}, "cosmos-sdk/MsgGrantAuthorization", nil)
+	cdc.RegisterConcrete(MsgRevokeAuthorization{}, "cosmos-sdk/MsgRevokeAuthorization", nil)
+	cdc.RegisterConcrete(MsgExecAuthorized{}, "cosmos-sdk/MsgExecAuthorized", nil)
+	cdc.RegisterConcrete(SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+}
+
+// RegisterAuthorizationTypeCodec registers an external concrete Authorization
+// type defined in another module for the internal ModuleCdc. This allows the
+// MsgGrantAuthorization type to be correctly Amino encoded and decoded.
+func RegisterAuthorizationTypeCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeAuthorizationNotFound sdk.CodeType = 1
+	CodeInvalidAuthorization  sdk.CodeType = 2
+	CodeInvalidExpiration     sdk.CodeType = 3
+	CodeUnauthorizedMsg       sdk.CodeType = 4
+	CodeInvalidExecMsg        sdk.CodeType = 5
+)
+
+// ErrAuthorizationNotFound returns a typed error when no unexpired
+// authorization exists for the given granter, grantee and message type
+func ErrAuthorizationNotFound(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthorizationNotFound,
+		fmt.Sprintf("no authorization for %s found from granter %s to grantee %s", msgType, granter, grantee))
+}
+
+// ErrInvalidAuthorization returns a typed error when an authorization is invalid
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, fmt.Sprintf("invalid authorization: %s", msg))
+}
+
+// ErrInvalidExpiration returns a typed error when an authorization expiration
+// is not in the future
+func ErrInvalidExpiration(codespace sdk.CodespaceType, expiration string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiration, fmt.Sprintf("expiration %s must be in the future", expiration))
+}
+
+// ErrUnauthorizedMsg returns a typed error when an authorization does not
+// accept the message it is asked to execute
+func ErrUnauthorizedMsg(codespace sdk.CodespaceType, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedMsg, fmt.Sprintf("authorization does not accept %s message", msgType))
+}
+
+// ErrInvalidExecMsg returns a typed error when a message wrapped in a
+// MsgExecAuthorized cannot be executed on behalf of a granter
+func ErrInvalidExecMsg(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExecMsg, fmt.Sprintf("invalid message to execute: %s", msg))
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the authz module's genesis state.
+type GenesisState struct {
+	Authorizations []GrantAuthorization `json:"authorizations" yaml:"authorizations"`
+}
+
+// NewGenesisState creates a new genesis state for the authz module.
+func NewGenesisState(authorizations []GrantAuthorization) GenesisState {
+	return GenesisState{Authorizations: authorizations}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Authorizations: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for i, ga := range gs.Authorizations {
+		if ga.Granter.Empty() || ga.Grantee.Empty() {
+			return fmt.Errorf("authorization %d has an empty granter or grantee", i)
+		}
+		if ga.Authorization == nil {
+			return fmt.Errorf("authorization %d is nil", i)
+		}
+		if err := ga.Authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "authz"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for authz store
+// Items are stored with the following key: values
+//
+// - 0x00<granter_Bytes><grantee_Bytes><msgType_Bytes>: AuthorizationGrant
+var (
+	GrantKeyPrefix = []byte{0x00}
+)
+
+// GetGrantsKey returns the prefix of all the grants given by a granter to a
+// grantee
+func GetGrantsKey(granter, grantee sdk.AccAddress) []byte {
+	return append(append(GrantKeyPrefix, granter.Bytes()...), grantee.Bytes()...)
+}
+
+// GetGrantKey returns the key of the grant for a single message type given by
+// a granter to a grantee
+func GetGrantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGrantsKey(granter, grantee), []byte(msgType)...)
+}
+
+// SplitGrantKey splits a grant key into the granter and grantee addresses and
+// the message type
+func SplitGrantKey(key []byte) (granter, grantee sdk.AccAddress, msgType string) {
+	if len(key) < 1+2*sdk.AddrLen {
+		panic("unexpected grant key length")
+	}
+
+	key = key[1:] // remove prefix bytes
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	msgType = string(key[2*sdk.AddrLen:])
+	return
+}
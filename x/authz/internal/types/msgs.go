@@ -0,0 +1,202 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/authz/exported"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg = MsgGrantAuthorization{}
+	_ sdk.Msg = MsgRevokeAuthorization{}
+	_ sdk.Msg = MsgExecAuthorized{}
+)
+
+// MsgGrantAuthorization grants the grantee an authorization to execute a type
+// of message on behalf of the granter. A zero expiration means the
+// authorization does not expire.
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress         `json:"granter" yaml:"granter"`
+	Grantee       sdk.AccAddress         `json:"grantee" yaml:"grantee"`
+	Authorization exported.Authorization `json:"authorization" yaml:"authorization"`
+	Expiration    time.Time              `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization object.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization exported.Authorization, expiration time.Time,
+) MsgGrantAuthorization {
+
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return ErrInvalidAuthorization(DefaultCodespace, "granter and grantee cannot be the same")
+	}
+	if msg.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+
+	return msg.Authorization.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeAuthorization revokes an authorization previously given by the
+// granter to the grantee for a type of message.
+type MsgRevokeAuthorization struct {
+	Granter              sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee              sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	AuthorizationMsgType string         `json:"authorization_msg_type" yaml:"authorization_msg_type"`
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization object.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter:              granter,
+		Grantee:              grantee,
+		AuthorizationMsgType: msgType,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.AuthorizationMsgType == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing message type")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgExecAuthorized executes the wrapped messages on behalf of their signers,
+// each of which must have granted the grantee an authorization for the
+// message's type.
+type MsgExecAuthorized struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExecAuthorized creates a new MsgExecAuthorized object.
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExecAuthorized {
+	return MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic implements the sdk.Msg interface. Each of the wrapped messages
+// must itself be valid and have exactly one signer.
+func (msg MsgExecAuthorized) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrInvalidExecMsg(DefaultCodespace, "no messages to execute")
+	}
+
+	for _, m := range msg.Msgs {
+		if m == nil {
+			return ErrInvalidExecMsg(DefaultCodespace, "nil message")
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+		if len(m.GetSigners()) != 1 {
+			return ErrInvalidExecMsg(DefaultCodespace, "messages must have exactly one signer")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. The wrapped messages are
+// encoded with their own sign bytes so that the authz codec does not need to
+// know about every message type.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, len(msg.Msgs))
+	for i, m := range msg.Msgs {
+		msgs[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{
+		Grantee: msg.Grantee,
+		Msgs:    msgs,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	expiration := time.Now().Add(time.Hour)
+	sendAuthorization := NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgGrantAuthorization
+	}{
+		{true, NewMsgGrantAuthorization(granter, grantee, sendAuthorization, expiration)},
+		{true, NewMsgGrantAuthorization(granter, grantee, NewGenericAuthorization("bank/send"), time.Time{})},
+		{false, NewMsgGrantAuthorization(emptyAddr, grantee, sendAuthorization, expiration)},
+		{false, NewMsgGrantAuthorization(granter, emptyAddr, sendAuthorization, expiration)},
+		{false, NewMsgGrantAuthorization(granter, granter, sendAuthorization, expiration)},
+		{false, NewMsgGrantAuthorization(granter, grantee, nil, expiration)},
+		{false, NewMsgGrantAuthorization(granter, grantee, NewSendAuthorization(sdk.Coins{}), expiration)},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgGrantAuthorization(granter, grantee, sendAuthorization, expiration)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgGrantAuthorization, msg.Type())
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgRevokeAuthorization
+	}{
+		{true, NewMsgRevokeAuthorization(granter, grantee, "bank/send")},
+		{false, NewMsgRevokeAuthorization(emptyAddr, grantee, "bank/send")},
+		{false, NewMsgRevokeAuthorization(granter, emptyAddr, "bank/send")},
+		{false, NewMsgRevokeAuthorization(granter, grantee, "")},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgRevokeAuthorization(granter, grantee, "bank/send")
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgExecAuthorized
+	}{
+		{true, NewMsgExecAuthorized(grantee, []sdk.Msg{newMsgSend(granter, grantee, coins)})},
+		{false, NewMsgExecAuthorized(emptyAddr, []sdk.Msg{newMsgSend(granter, grantee, coins)})},
+		{false, NewMsgExecAuthorized(grantee, nil)},
+		{false, NewMsgExecAuthorized(grantee, []sdk.Msg{nil})},
+		{false, NewMsgExecAuthorized(grantee, []sdk.Msg{newMsgSend(granter, grantee, sdk.Coins{})})},
+		{false, NewMsgExecAuthorized(grantee, []sdk.Msg{bank.MsgMultiSend{
+			Inputs:  []bank.Input{bank.NewInput(granter, coins), bank.NewInput(grantee, coins)},
+			Outputs: []bank.Output{bank.NewOutput(grantee, coins.Add(coins))},
+		}})},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgExecAuthorized(grantee, []sdk.Msg{newMsgSend(granter, grantee, coins)})
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.Contains(t, string(msg.GetSignBytes()), `"type":"cosmos-sdk/MsgSend"`)
+}
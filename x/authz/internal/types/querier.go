@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Querier routes for the authz module
+const (
+	QueryAuthorization  = "authorization"
+	QueryAuthorizations = "authorizations"
+)
+
+// QueryAuthorizationParams defines the parameters necessary for querying the
+// authorization for a single message type.
+type QueryAuthorizationParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgType string         `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewQueryAuthorizationParams creates a new QueryAuthorizationParams object
+func NewQueryAuthorizationParams(granter, grantee sdk.AccAddress, msgType string) QueryAuthorizationParams {
+	return QueryAuthorizationParams{Granter: granter, Grantee: grantee, MsgType: msgType}
+}
+
+// QueryAuthorizationsParams defines the parameters necessary for querying all
+// the authorizations given by a granter to a grantee.
+type QueryAuthorizationsParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryAuthorizationsParams creates a new QueryAuthorizationsParams object
+func NewQueryAuthorizationsParams(granter, grantee sdk.AccAddress) QueryAuthorizationsParams {
+	return QueryAuthorizationsParams{Granter: granter, Grantee: grantee}
+}
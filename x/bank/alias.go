@@ -13,6 +13,8 @@ const (
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeAccountExists        = types.CodeAccountExists
+	CodeInvalidVestingTime   = types.CodeInvalidVestingTime
 	ModuleName               = types.ModuleName
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
@@ -21,26 +23,36 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec          = types.RegisterCodec
-	ErrNoInputs            = types.ErrNoInputs
-	ErrNoOutputs           = types.ErrNoOutputs
-	ErrInputOutputMismatch = types.ErrInputOutputMismatch
-	ErrSendDisabled        = types.ErrSendDisabled
-	NewBaseKeeper          = keeper.NewBaseKeeper
-	NewInput               = types.NewInput
-	NewOutput              = types.NewOutput
-	ParamKeyTable          = types.ParamKeyTable
+	RegisterCodec              = types.RegisterCodec
+	ErrNoInputs                = types.ErrNoInputs
+	ErrNoOutputs               = types.ErrNoOutputs
+	ErrInputOutputMismatch     = types.ErrInputOutputMismatch
+	ErrSendDisabled            = types.ErrSendDisabled
+	ErrSendDisabledDenom       = types.ErrSendDisabledDenom
+	ErrAccountExists           = types.ErrAccountExists
+	ErrInvalidVestingEndTime   = types.ErrInvalidVestingEndTime
+	NewBaseKeeper              = keeper.NewBaseKeeper
+	NewInput                   = types.NewInput
+	NewOutput                  = types.NewOutput
+	NewMsgSend                 = types.NewMsgSend
+	NewMsgCreateVestingAccount = types.NewMsgCreateVestingAccount
+	ParamKeyTable              = types.ParamKeyTable
+	NewSendEnabled             = types.NewSendEnabled
+	ValidateSendEnabledDenoms  = types.ValidateSendEnabledDenoms
 
 	// variable aliases
-	ModuleCdc                = types.ModuleCdc
-	ParamStoreKeySendEnabled = types.ParamStoreKeySendEnabled
+	ModuleCdc                      = types.ModuleCdc
+	ParamStoreKeySendEnabled       = types.ParamStoreKeySendEnabled
+	ParamStoreKeySendEnabledDenoms = types.ParamStoreKeySendEnabledDenoms
 )
 
 type (
-	BaseKeeper   = keeper.BaseKeeper // ibc module depends on this
-	Keeper       = keeper.Keeper
-	MsgSend      = types.MsgSend
-	MsgMultiSend = types.MsgMultiSend
-	Input        = types.Input
-	Output       = types.Output
+	BaseKeeper              = keeper.BaseKeeper // ibc module depends on this
+	Keeper                  = keeper.Keeper
+	MsgSend                 = types.MsgSend
+	MsgMultiSend            = types.MsgMultiSend
+	MsgCreateVestingAccount = types.MsgCreateVestingAccount
+	Input                   = types.Input
+	Output                  = types.Output
+	SendEnabled             = types.SendEnabled
 )
@@ -1,15 +1,27 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/hyperspeednetwork/hsnhub/client"
 	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/client/flags"
 	"github.com/hyperspeednetwork/hsnhub/codec"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
 	"github.com/hyperspeednetwork/hsnhub/x/bank/internal/types"
+	supplyutils "github.com/hyperspeednetwork/hsnhub/x/supply/client/utils"
+)
+
+const (
+	flagDelayed = "delayed"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -23,6 +35,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
+		CreateVestingAccountCmd(cdc),
 	)
 	return txCmd
 }
@@ -32,7 +45,19 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send [from_key_or_address] [to_address] [amount]",
 		Short: "Create and sign a send tx",
-		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create and sign a send tx. With the --display-units flag the amount may be
+given in any unit registered in the denom metadata, which is converted to the
+base unit before the tx is built.
+
+Example:
+$ %s tx bank send mykey cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000uhsn
+$ %s tx bank send mykey cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1.5hsn --display-units
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
@@ -43,7 +68,12 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// parse coins trying to be sent
-			coins, err := sdk.ParseCoins(args[2])
+			var coins sdk.Coins
+			if viper.GetBool(flags.FlagDisplayUnits) {
+				coins, err = supplyutils.ParseDisplayCoins(cliCtx, args[2])
+			} else {
+				coins, err = sdk.ParseCoins(args[2])
+			}
 			if err != nil {
 				return err
 			}
@@ -54,6 +84,60 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flags.FlagDisplayUnits, false, "Accept the amount in any unit registered in the denom metadata")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreateVestingAccountCmd will create a tx that funds a new vesting account
+// and sign it with the given key.
+func CreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [from_key_or_address] [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new vesting account funded with an allocation of tokens from the
+sender's balance. The account vests continuously from the current block time
+until the given end time (UNIX epoch), or all at once at the end time if the
+--delayed flag is set. The recipient address must not already exist.
+
+Example:
+$ %s tx bank create-vesting-account mykey cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake 1640995200 --delayed
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, amount, endTime, viper.GetBool(flagDelayed))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagDelayed, false, "Create a delayed vesting account if true")
 	cmd = client.PostCommands(cmd)[0]
 
 	return cmd
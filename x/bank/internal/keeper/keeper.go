@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/auth/exported"
+	authtypes "github.com/hyperspeednetwork/hsnhub/x/auth/types"
 	"github.com/hyperspeednetwork/hsnhub/x/bank/internal/types"
 	"github.com/hyperspeednetwork/hsnhub/x/params"
 )
@@ -21,6 +22,8 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, endTime int64, delayed bool) sdk.Error
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
@@ -131,6 +134,56 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 	return nil
 }
 
+// CreateVestingAccount creates a new vesting account at toAddr funded with amt
+// from fromAddr. The account vests continuously from the current block time
+// until endTime, or all at once at endTime if delayed is set. An error is
+// returned if an account already exists at toAddr.
+func (keeper BaseKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress,
+	amt sdk.Coins, endTime int64, delayed bool) sdk.Error {
+
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return types.ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	startTime := ctx.BlockHeader().Time.Unix()
+	if endTime <= startTime {
+		return types.ErrInvalidVestingEndTime(keeper.Codespace(), endTime)
+	}
+
+	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	// create the account through the account keeper so that it is assigned the
+	// next account number
+	acc := keeper.ak.NewAccountWithAddress(ctx, toAddr)
+	baseAcc := authtypes.NewBaseAccount(toAddr, amt, nil, acc.GetAccountNumber(), 0)
+
+	var vacc exported.VestingAccount
+	if delayed {
+		vacc = authtypes.NewDelayedVestingAccount(baseAcc, endTime)
+	} else {
+		vacc = authtypes.NewContinuousVestingAccount(baseAcc, startTime, endTime)
+	}
+
+	keeper.ak.SetAccount(ctx, vacc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		),
+	})
+
+	return nil
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -145,6 +198,9 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled
+	SetSendEnabledDenoms(ctx sdk.Context, sendEnabledDenoms []types.SendEnabled)
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
 }
@@ -314,7 +370,8 @@ func (keeper BaseSendKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 	return nil
 }
 
-// GetSendEnabled returns the current SendEnabled
+// GetSendEnabled returns the current default SendEnabled, which applies to
+// every denomination without its own setting
 // nolint: errcheck
 func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
 	var enabled bool
@@ -322,11 +379,48 @@ func (keeper BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
 	return enabled
 }
 
-// SetSendEnabled sets the send enabled
+// SetSendEnabled sets the default send enabled
 func (keeper BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
 }
 
+// GetSendEnabledDenoms returns the per-denomination SendEnabled settings
+func (keeper BaseSendKeeper) GetSendEnabledDenoms(ctx sdk.Context) []types.SendEnabled {
+	sendEnabledDenoms := []types.SendEnabled{}
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabledDenoms)
+	return sendEnabledDenoms
+}
+
+// SetSendEnabledDenoms sets the per-denomination SendEnabled settings
+func (keeper BaseSendKeeper) SetSendEnabledDenoms(ctx sdk.Context, sendEnabledDenoms []types.SendEnabled) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeySendEnabledDenoms, &sendEnabledDenoms)
+}
+
+// IsSendEnabledCoins returns an error naming the first of the given coins
+// whose denomination can't be transferred. A denomination without its own
+// setting uses the default SendEnabled.
+func (keeper BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error {
+	defaultEnabled := keeper.GetSendEnabled(ctx)
+
+	sendEnabledDenoms := make(map[string]bool)
+	for _, se := range keeper.GetSendEnabledDenoms(ctx) {
+		sendEnabledDenoms[se.Denom] = se.Enabled
+	}
+
+	for _, coin := range coins {
+		enabled, ok := sendEnabledDenoms[coin.Denom]
+		if !ok {
+			enabled = defaultEnabled
+		}
+
+		if !enabled {
+			return types.ErrSendDisabledDenom(keeper.Codespace(), coin.Denom)
+		}
+	}
+
+	return nil
+}
+
 // BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
 // receiving funds)
 func (keeper BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
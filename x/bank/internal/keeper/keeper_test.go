@@ -12,6 +12,7 @@ import (
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	"github.com/hyperspeednetwork/hsnhub/x/bank/internal/types"
+	"github.com/hyperspeednetwork/hsnhub/x/params"
 )
 
 func TestKeeper(t *testing.T) {
@@ -331,3 +332,113 @@ func TestUndelegateCoins(t *testing.T) {
 	require.Equal(t, origCoins, vacc.GetCoins())
 	require.True(t, macc.GetCoins().Empty())
 }
+
+func TestCreateVestingAccount(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour).Unix()
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	vestCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	acc := input.ak.NewAccountWithAddress(ctx, addr1)
+	input.ak.SetAccount(ctx, acc)
+	input.k.SetCoins(ctx, addr1, origCoins)
+
+	// end time must be in the future
+	err := input.k.CreateVestingAccount(ctx, addr1, addr2, vestCoins, now.Unix(), false)
+	require.Error(t, err)
+
+	// funder must hold enough coins
+	err = input.k.CreateVestingAccount(ctx, addr1, addr2, origCoins.Add(vestCoins), endTime, false)
+	require.Error(t, err)
+	require.Nil(t, input.ak.GetAccount(ctx, addr2))
+
+	err = input.k.CreateVestingAccount(ctx, addr1, addr2, vestCoins, endTime, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), input.k.GetCoins(ctx, addr1))
+
+	cvacc, ok := input.ak.GetAccount(ctx, addr2).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, vestCoins, cvacc.GetOriginalVesting())
+	require.Equal(t, vestCoins, cvacc.GetCoins())
+	require.Equal(t, now.Unix(), cvacc.GetStartTime())
+	require.Equal(t, endTime, cvacc.GetEndTime())
+
+	// existing accounts are rejected
+	err = input.k.CreateVestingAccount(ctx, addr1, addr2, vestCoins, endTime, false)
+	require.Error(t, err)
+	err = input.k.CreateVestingAccount(ctx, addr2, addr1, vestCoins, endTime, false)
+	require.Error(t, err)
+
+	err = input.k.CreateVestingAccount(ctx, addr1, addr3, vestCoins, endTime, true)
+	require.NoError(t, err)
+
+	dvacc, ok := input.ak.GetAccount(ctx, addr3).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, vestCoins, dvacc.GetOriginalVesting())
+	require.Equal(t, endTime, dvacc.GetEndTime())
+	require.True(t, dvacc.GetAccountNumber() > cvacc.GetAccountNumber())
+}
+
+func TestSendEnabledDenoms(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	fooCoin := sdk.NewInt64Coin("foocoin", 10)
+	barCoin := sdk.NewInt64Coin("barcoin", 10)
+
+	// every denom uses the default without its own setting
+	require.Empty(t, input.k.GetSendEnabledDenoms(ctx))
+	require.Nil(t, input.k.IsSendEnabledCoins(ctx, fooCoin, barCoin))
+
+	input.k.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled("barcoin", false)})
+	require.Nil(t, input.k.IsSendEnabledCoins(ctx, fooCoin))
+
+	err := input.k.IsSendEnabledCoins(ctx, fooCoin, barCoin)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSendDisabled, err.Code())
+	require.Contains(t, err.Error(), "barcoin")
+
+	// a per-denom setting overrides the default
+	input.k.SetSendEnabled(ctx, false)
+	input.k.SetSendEnabledDenoms(ctx, []types.SendEnabled{types.NewSendEnabled("foocoin", true)})
+	require.Nil(t, input.k.IsSendEnabledCoins(ctx, fooCoin))
+	require.NotNil(t, input.k.IsSendEnabledCoins(ctx, barCoin))
+}
+
+func TestSendEnabledDenomsParamChange(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	proposal := params.NewParameterChangeProposal("title", "description", []params.ParamChange{
+		params.NewParamChange(
+			types.DefaultParamspace,
+			string(types.ParamStoreKeySendEnabledDenoms),
+			`[{"denom":"barcoin","enabled":false}]`,
+		),
+	})
+
+	handler := params.NewParamChangeProposalHandler(input.pk)
+	require.Nil(t, handler(ctx, proposal))
+
+	require.Equal(t, []types.SendEnabled{types.NewSendEnabled("barcoin", false)}, input.k.GetSendEnabledDenoms(ctx))
+	require.NotNil(t, input.k.IsSendEnabledCoins(ctx, sdk.NewInt64Coin("barcoin", 1)))
+
+	// invalid and duplicate denoms are rejected and leave the settings intact
+	for _, value := range []string{
+		`[{"denom":"Bar","enabled":true}]`,
+		`[{"denom":"foocoin","enabled":true},{"denom":"foocoin","enabled":false}]`,
+	} {
+		proposal := params.NewParameterChangeProposal("title", "description", []params.ParamChange{
+			params.NewParamChange(types.DefaultParamspace, string(types.ParamStoreKeySendEnabledDenoms), value),
+		})
+		require.NotNil(t, handler(ctx, proposal), value)
+	}
+	require.Equal(t, []types.SendEnabled{types.NewSendEnabled("barcoin", false)}, input.k.GetSendEnabledDenoms(ctx))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
@@ -10,6 +12,8 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeAccountExists        sdk.CodeType = 103
+	CodeInvalidVestingTime   sdk.CodeType = 104
 )
 
 // ErrNoInputs is an error
@@ -31,3 +35,18 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrSendDisabledDenom is an error
+func ErrSendDisabledDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
+// ErrAccountExists is an error
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
+
+// ErrInvalidVestingEndTime is an error
+func ErrInvalidVestingEndTime(codespace sdk.CodespaceType, endTime int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingTime, fmt.Sprintf("invalid vesting end time: %d", endTime))
+}
@@ -101,6 +101,67 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgCreateVestingAccount defines a message that enables creating a vesting
+// account funded from the sender's balance.
+type MsgCreateVestingAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	EndTime     int64          `json:"end_time" yaml:"end_time"` // vesting end time (UNIX Epoch time)
+	Delayed     bool           `json:"delayed" yaml:"delayed"`   // vest all coins at end time instead of continuously
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct a msg to create and fund a vesting account.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	endTime int64, delayed bool) MsgCreateVestingAccount {
+
+	return MsgCreateVestingAccount{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.EndTime <= 0 {
+		return ErrInvalidVestingEndTime(DefaultCodespace, msg.EndTime)
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
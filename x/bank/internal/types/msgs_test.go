@@ -260,3 +260,33 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 100000, false)},      // valid continuous
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 100000, true)},       // valid delayed
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 100000, false)},       // non positive coin
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 100000, false)}, // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 100000, false)}, // empty to addr
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false)},          // invalid end time
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
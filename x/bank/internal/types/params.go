@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/params"
 )
 
@@ -11,12 +14,65 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter store keys
+var (
+	// ParamStoreKeySendEnabled is store's key for SendEnabled, the default
+	// applied to any denomination without its own setting
+	ParamStoreKeySendEnabled = []byte("sendenabled")
+	// ParamStoreKeySendEnabledDenoms is store's key for the per-denomination
+	// SendEnabled settings
+	ParamStoreKeySendEnabledDenoms = []byte("sendenableddenoms")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeySendEnabled, false,
-	)
+		ParamStoreKeySendEnabledDenoms, []SendEnabled{},
+	).RegisterValidator(ParamStoreKeySendEnabledDenoms, validateSendEnabledDenomsParam)
+}
+
+// SendEnabled defines whether coins of a denomination can be transferred,
+// overriding the default SendEnabled parameter for that denomination
+type SendEnabled struct {
+	Denom   string `json:"denom" yaml:"denom"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled instance
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// String implements the Stringer interface.
+func (se SendEnabled) String() string {
+	return fmt.Sprintf("%s: %t", se.Denom, se.Enabled)
+}
+
+// ValidateSendEnabledDenoms checks that the per-denomination SendEnabled
+// settings have valid and unique denominations.
+func ValidateSendEnabledDenoms(sendEnabledDenoms []SendEnabled) error {
+	seenDenoms := make(map[string]bool)
+	for _, se := range sendEnabledDenoms {
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[se.Denom] {
+			return fmt.Errorf("duplicate send enabled setting for denom %s", se.Denom)
+		}
+		seenDenoms[se.Denom] = true
+	}
+
+	return nil
+}
+
+func validateSendEnabledDenomsParam(i interface{}) error {
+	sendEnabledDenoms, ok := i.([]SendEnabled)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateSendEnabledDenoms(sendEnabledDenoms)
 }
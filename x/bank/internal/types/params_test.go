@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSendEnabledDenoms(t *testing.T) {
+	require.NoError(t, ValidateSendEnabledDenoms(nil))
+	require.NoError(t, ValidateSendEnabledDenoms([]SendEnabled{
+		NewSendEnabled("foocoin", true),
+		NewSendEnabled("barcoin", false),
+	}))
+
+	require.Error(t, ValidateSendEnabledDenoms([]SendEnabled{NewSendEnabled("FOO", true)}))
+	require.Error(t, ValidateSendEnabledDenoms([]SendEnabled{
+		NewSendEnabled("foocoin", true),
+		NewSendEnabled("foocoin", false),
+	}))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hyperspeednetwork/hsnhub/baseapp"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/exported"
 	"github.com/hyperspeednetwork/hsnhub/x/bank/internal/keeper"
 	"github.com/hyperspeednetwork/hsnhub/x/bank/internal/types"
 	"github.com/hyperspeednetwork/hsnhub/x/mock"
@@ -216,3 +217,55 @@ func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper types.AccountKeeper,
 	}
 	return nil
 }
+
+// SimulateMsgCreateVestingAccount tests and runs a single msg create vesting
+// account where the sender already exists and the recipient is a new address.
+func SimulateMsgCreateVestingAccount(mapper types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	handler := NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		fromAcc := simulation.RandomAcc(r, accs)
+		toAcc := simulation.RandomAccounts(r, 1)[0]
+		if mapper.GetAccount(ctx, toAcc.Address) != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		initFromCoins := mapper.GetAccount(ctx, fromAcc.Address).SpendableCoins(ctx.BlockHeader().Time)
+		if len(initFromCoins) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		denomIndex := r.Intn(len(initFromCoins))
+		amt, goErr := simulation.RandPositiveInt(r, initFromCoins[denomIndex].Amount)
+		if goErr != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		coins := sdk.Coins{sdk.NewCoin(initFromCoins[denomIndex].Denom, amt)}
+		endTime := ctx.BlockHeader().Time.Unix() + int64(simulation.RandIntBetween(r, 1, 60*60*24*30))
+		msg := types.NewMsgCreateVestingAccount(fromAcc.Address, toAcc.Address, coins, endTime, r.Intn(2) == 0)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			if res.Code == types.CodeSendDisabled {
+				return simulation.NewOperationMsg(msg, false, ""), nil, nil
+			}
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("handling msg failed %v", res)
+		}
+
+		vacc, ok := mapper.GetAccount(ctx, toAcc.Address).(exported.VestingAccount)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("account %s is not a vesting account", toAcc.Address)
+		}
+		if !vacc.GetOriginalVesting().IsEqual(coins) {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("vesting account %s has an incorrect original vesting amount", toAcc.Address)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -29,4 +29,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay the community pool streams due at this height
+	k.ProcessCommunityPoolStreams(ctx)
+
+	// restake the rewards of the delegators that enabled auto restaking
+	k.ProcessAutoRestakes(ctx)
 }
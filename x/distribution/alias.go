@@ -13,30 +13,48 @@ import (
 )
 
 const (
-	DefaultParamspace                = keeper.DefaultParamspace
-	DefaultCodespace                 = types.DefaultCodespace
-	CodeInvalidInput                 = types.CodeInvalidInput
-	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
-	CodeNoValidatorCommission        = types.CodeNoValidatorCommission
-	CodeSetWithdrawAddrDisabled      = types.CodeSetWithdrawAddrDisabled
-	ModuleName                       = types.ModuleName
-	StoreKey                         = types.StoreKey
-	RouterKey                        = types.RouterKey
-	QuerierRoute                     = types.QuerierRoute
-	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
-	QueryParams                      = types.QueryParams
-	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
-	QueryValidatorCommission         = types.QueryValidatorCommission
-	QueryValidatorSlashes            = types.QueryValidatorSlashes
-	QueryDelegationRewards           = types.QueryDelegationRewards
-	QueryDelegatorTotalRewards       = types.QueryDelegatorTotalRewards
-	QueryDelegatorValidators         = types.QueryDelegatorValidators
-	QueryWithdrawAddr                = types.QueryWithdrawAddr
-	QueryCommunityPool               = types.QueryCommunityPool
-	ParamCommunityTax                = types.ParamCommunityTax
-	ParamBaseProposerReward          = types.ParamBaseProposerReward
-	ParamBonusProposerReward         = types.ParamBonusProposerReward
-	ParamWithdrawAddrEnabled         = types.ParamWithdrawAddrEnabled
+	DefaultParamspace                     = keeper.DefaultParamspace
+	DefaultCodespace                      = types.DefaultCodespace
+	CodeInvalidInput                      = types.CodeInvalidInput
+	CodeNoDistributionInfo                = types.CodeNoDistributionInfo
+	CodeNoValidatorCommission             = types.CodeNoValidatorCommission
+	CodeSetWithdrawAddrDisabled           = types.CodeSetWithdrawAddrDisabled
+	CodeUnknownStream                     = types.CodeUnknownStream
+	ModuleName                            = types.ModuleName
+	StoreKey                              = types.StoreKey
+	RouterKey                             = types.RouterKey
+	QuerierRoute                          = types.QuerierRoute
+	ProposalTypeCommunityPoolSpend        = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeCommunityPoolStream       = types.ProposalTypeCommunityPoolStream
+	ProposalTypeCancelCommunityPoolStream = types.ProposalTypeCancelCommunityPoolStream
+	QueryParams                           = types.QueryParams
+	QueryValidatorOutstandingRewards      = types.QueryValidatorOutstandingRewards
+	QueryValidatorCommission              = types.QueryValidatorCommission
+	QueryValidatorSlashes                 = types.QueryValidatorSlashes
+	QueryDelegationRewards                = types.QueryDelegationRewards
+	QueryDelegatorTotalRewards            = types.QueryDelegatorTotalRewards
+	QueryDelegatorValidators              = types.QueryDelegatorValidators
+	QueryWithdrawAddr                     = types.QueryWithdrawAddr
+	QueryCommunityPool                    = types.QueryCommunityPool
+	QueryAutoRestake                      = types.QueryAutoRestake
+	QueryAutoRestakeDelegators            = types.QueryAutoRestakeDelegators
+	QueryValidatorMissedRewards           = types.QueryValidatorMissedRewards
+	QueryCommunityPoolStreams             = types.QueryCommunityPoolStreams
+	QueryCommunityPoolStream              = types.QueryCommunityPoolStream
+	QueryNetworkAPR                       = types.QueryNetworkAPR
+	QueryValidatorAPR                     = types.QueryValidatorAPR
+	QueryEstimatedRewards                 = types.QueryEstimatedRewards
+	ParamCommunityTax                     = types.ParamCommunityTax
+	ParamBaseProposerReward               = types.ParamBaseProposerReward
+	ParamBonusProposerReward              = types.ParamBonusProposerReward
+	ParamWithdrawAddrEnabled              = types.ParamWithdrawAddrEnabled
+	ParamAutoRestakeInterval              = types.ParamAutoRestakeInterval
+	ParamMaxAutoRestakeDelegations        = types.ParamMaxAutoRestakeDelegations
+	ParamMissedVoteRewardsRecipient       = types.ParamMissedVoteRewardsRecipient
+
+	MissedVoteRewardsRecipientNone          = types.MissedVoteRewardsRecipientNone
+	MissedVoteRewardsRecipientCommunityPool = types.MissedVoteRewardsRecipientCommunityPool
+	MissedVoteRewardsRecipientSigners       = types.MissedVoteRewardsRecipientSigners
 )
 
 var (
@@ -50,6 +68,8 @@ var (
 	NewKeeper                                  = keeper.NewKeeper
 	GetValidatorOutstandingRewardsAddress      = keeper.GetValidatorOutstandingRewardsAddress
 	GetDelegatorWithdrawInfoAddress            = keeper.GetDelegatorWithdrawInfoAddress
+	GetDelegatorAutoRestakeAddress             = keeper.GetDelegatorAutoRestakeAddress
+	GetValidatorMissedRewardsAddress           = keeper.GetValidatorMissedRewardsAddress
 	GetDelegatorStartingInfoAddresses          = keeper.GetDelegatorStartingInfoAddresses
 	GetValidatorHistoricalRewardsAddressPeriod = keeper.GetValidatorHistoricalRewardsAddressPeriod
 	GetValidatorCurrentRewardsAddress          = keeper.GetValidatorCurrentRewardsAddress
@@ -57,6 +77,10 @@ var (
 	GetValidatorSlashEventAddressHeight        = keeper.GetValidatorSlashEventAddressHeight
 	GetValidatorOutstandingRewardsKey          = keeper.GetValidatorOutstandingRewardsKey
 	GetDelegatorWithdrawAddrKey                = keeper.GetDelegatorWithdrawAddrKey
+	GetDelegatorAutoRestakeKey                 = keeper.GetDelegatorAutoRestakeKey
+	GetValidatorMissedRewardsKey               = keeper.GetValidatorMissedRewardsKey
+	GetCommunityPoolStreamID                   = keeper.GetCommunityPoolStreamID
+	GetCommunityPoolStreamKey                  = keeper.GetCommunityPoolStreamKey
 	GetDelegatorStartingInfoKey                = keeper.GetDelegatorStartingInfoKey
 	GetValidatorHistoricalRewardsPrefix        = keeper.GetValidatorHistoricalRewardsPrefix
 	GetValidatorHistoricalRewardsKey           = keeper.GetValidatorHistoricalRewardsKey
@@ -67,12 +91,15 @@ var (
 	GetValidatorSlashEventKey                  = keeper.GetValidatorSlashEventKey
 	ParamKeyTable                              = keeper.ParamKeyTable
 	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
+	HandleCommunityPoolStreamProposal          = keeper.HandleCommunityPoolStreamProposal
+	HandleCancelCommunityPoolStreamProposal    = keeper.HandleCancelCommunityPoolStreamProposal
 	NewQuerier                                 = keeper.NewQuerier
 	MakeTestCodec                              = keeper.MakeTestCodec
 	CreateTestInputDefault                     = keeper.CreateTestInputDefault
 	CreateTestInputAdvanced                    = keeper.CreateTestInputAdvanced
 	RegisterCodec                              = types.RegisterCodec
 	NewDelegatorStartingInfo                   = types.NewDelegatorStartingInfo
+	NewAutoRestakeCursor                       = types.NewAutoRestakeCursor
 	ErrNilDelegatorAddr                        = types.ErrNilDelegatorAddr
 	ErrNilWithdrawAddr                         = types.ErrNilWithdrawAddr
 	ErrNilValidatorAddr                        = types.ErrNilValidatorAddr
@@ -83,21 +110,38 @@ var (
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
+	ErrInvalidCommunityPoolStream              = types.ErrInvalidCommunityPoolStream
+	ErrUnknownCommunityPoolStream              = types.ErrUnknownCommunityPoolStream
 	InitialFeePool                             = types.InitialFeePool
 	NewGenesisState                            = types.NewGenesisState
 	DefaultGenesisState                        = types.DefaultGenesisState
 	ValidateGenesis                            = types.ValidateGenesis
+	ValidateMissedVoteRewardsRecipient         = types.ValidateMissedVoteRewardsRecipient
 	NewMsgSetWithdrawAddress                   = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
+	NewMsgSetAutoRestake                       = types.NewMsgSetAutoRestake
+	NewMsgFundCommunityPool                    = types.NewMsgFundCommunityPool
+	NewMsgCommunityPoolSpend                   = types.NewMsgCommunityPoolSpend
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
+	NewCommunityPoolStreamProposal             = types.NewCommunityPoolStreamProposal
+	NewCancelCommunityPoolStreamProposal       = types.NewCancelCommunityPoolStreamProposal
+	NewQueryCommunityPoolStreamParams          = types.NewQueryCommunityPoolStreamParams
+	NewCommunityPoolStream                     = types.NewCommunityPoolStream
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
+	NewQueryValidatorMissedRewardsParams       = types.NewQueryValidatorMissedRewardsParams
+	NewQueryValidatorAPRParams                 = types.NewQueryValidatorAPRParams
+	NewQueryEstimatedRewardsParams             = types.NewQueryEstimatedRewardsParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
 	NewQueryDelegationRewardsParams            = types.NewQueryDelegationRewardsParams
 	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
+	NewQueryDelegatorAutoRestakeParams         = types.NewQueryDelegatorAutoRestakeParams
 	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
+	NewQueryDelegatorAutoRestakeResponse       = types.NewQueryDelegatorAutoRestakeResponse
+	NewQueryNetworkAPRResponse                 = types.NewQueryNetworkAPRResponse
+	NewQueryValidatorAPRResponse               = types.NewQueryValidatorAPRResponse
 	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
 	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
@@ -105,37 +149,58 @@ var (
 	NewValidatorSlashEvent                     = types.NewValidatorSlashEvent
 
 	// variable aliases
-	FeePoolKey                           = keeper.FeePoolKey
-	ProposerKey                          = keeper.ProposerKey
-	ValidatorOutstandingRewardsPrefix    = keeper.ValidatorOutstandingRewardsPrefix
-	DelegatorWithdrawAddrPrefix          = keeper.DelegatorWithdrawAddrPrefix
-	DelegatorStartingInfoPrefix          = keeper.DelegatorStartingInfoPrefix
-	ValidatorHistoricalRewardsPrefix     = keeper.ValidatorHistoricalRewardsPrefix
-	ValidatorCurrentRewardsPrefix        = keeper.ValidatorCurrentRewardsPrefix
-	ValidatorAccumulatedCommissionPrefix = keeper.ValidatorAccumulatedCommissionPrefix
-	ValidatorSlashEventPrefix            = keeper.ValidatorSlashEventPrefix
-	ParamStoreKeyCommunityTax            = keeper.ParamStoreKeyCommunityTax
-	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
-	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
-	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
-	TestAddrs                            = keeper.TestAddrs
-	ModuleCdc                            = types.ModuleCdc
-	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
-	EventTypeRewards                     = types.EventTypeRewards
-	EventTypeCommission                  = types.EventTypeCommission
-	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
-	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
-	EventTypeProposerReward              = types.EventTypeProposerReward
-	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
-	AttributeKeyValidator                = types.AttributeKeyValidator
-	AttributeValueCategory               = types.AttributeValueCategory
-	ProposalHandler                      = client.ProposalHandler
+	FeePoolKey                              = keeper.FeePoolKey
+	ProposerKey                             = keeper.ProposerKey
+	ValidatorOutstandingRewardsPrefix       = keeper.ValidatorOutstandingRewardsPrefix
+	DelegatorWithdrawAddrPrefix             = keeper.DelegatorWithdrawAddrPrefix
+	DelegatorStartingInfoPrefix             = keeper.DelegatorStartingInfoPrefix
+	ValidatorHistoricalRewardsPrefix        = keeper.ValidatorHistoricalRewardsPrefix
+	ValidatorCurrentRewardsPrefix           = keeper.ValidatorCurrentRewardsPrefix
+	ValidatorAccumulatedCommissionPrefix    = keeper.ValidatorAccumulatedCommissionPrefix
+	ValidatorSlashEventPrefix               = keeper.ValidatorSlashEventPrefix
+	DelegatorAutoRestakePrefix              = keeper.DelegatorAutoRestakePrefix
+	AutoRestakeCursorKey                    = keeper.AutoRestakeCursorKey
+	ValidatorMissedRewardsPrefix            = keeper.ValidatorMissedRewardsPrefix
+	CommunityPoolStreamPrefix               = keeper.CommunityPoolStreamPrefix
+	NextCommunityPoolStreamIDKey            = keeper.NextCommunityPoolStreamIDKey
+	ParamStoreKeyCommunityTax               = keeper.ParamStoreKeyCommunityTax
+	ParamStoreKeyBaseProposerReward         = keeper.ParamStoreKeyBaseProposerReward
+	ParamStoreKeyBonusProposerReward        = keeper.ParamStoreKeyBonusProposerReward
+	ParamStoreKeyWithdrawAddrEnabled        = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoRestakeInterval        = keeper.ParamStoreKeyAutoRestakeInterval
+	ParamStoreKeyMaxAutoRestakeDelegations  = keeper.ParamStoreKeyMaxAutoRestakeDelegations
+	ParamStoreKeyMissedVoteRewardsRecipient = keeper.ParamStoreKeyMissedVoteRewardsRecipient
+	TestAddrs                               = keeper.TestAddrs
+	ModuleCdc                               = types.ModuleCdc
+	EventTypeSetWithdrawAddress             = types.EventTypeSetWithdrawAddress
+	EventTypeRewards                        = types.EventTypeRewards
+	EventTypeCommission                     = types.EventTypeCommission
+	EventTypeWithdrawRewards                = types.EventTypeWithdrawRewards
+	EventTypeWithdrawCommission             = types.EventTypeWithdrawCommission
+	EventTypeProposerReward                 = types.EventTypeProposerReward
+	EventTypeSetAutoRestake                 = types.EventTypeSetAutoRestake
+	EventTypeAutoRestake                    = types.EventTypeAutoRestake
+	EventTypeMissedRewards                  = types.EventTypeMissedRewards
+	EventTypeFundCommunityPool              = types.EventTypeFundCommunityPool
+	EventTypeCommunityPoolSpend             = types.EventTypeCommunityPoolSpend
+	EventTypeStreamPayment                  = types.EventTypeStreamPayment
+	AttributeKeyWithdrawAddress             = types.AttributeKeyWithdrawAddress
+	AttributeKeyValidator                   = types.AttributeKeyValidator
+	AttributeKeyDelegator                   = types.AttributeKeyDelegator
+	AttributeKeyEnabled                     = types.AttributeKeyEnabled
+	AttributeKeyStreamID                    = types.AttributeKeyStreamID
+	AttributeKeyRecipient                   = types.AttributeKeyRecipient
+	AttributeValueCategory                  = types.AttributeValueCategory
+	ProposalHandler                         = client.ProposalHandler
+	StreamProposalHandler                   = client.StreamProposalHandler
+	CancelStreamProposalHandler             = client.CancelStreamProposalHandler
 )
 
 type (
 	Hooks                                  = keeper.Hooks
 	Keeper                                 = keeper.Keeper
 	DelegatorStartingInfo                  = types.DelegatorStartingInfo
+	AutoRestakeCursor                      = types.AutoRestakeCursor
 	CodeType                               = types.CodeType
 	FeePool                                = types.FeePool
 	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
@@ -145,18 +210,35 @@ type (
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	ValidatorMissedRewardsRecord           = types.ValidatorMissedRewardsRecord
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoRestake                      = types.MsgSetAutoRestake
+	MsgFundCommunityPool                   = types.MsgFundCommunityPool
+	MsgCommunityPoolSpend                  = types.MsgCommunityPoolSpend
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	CommunityPoolStreamProposal            = types.CommunityPoolStreamProposal
+	CancelCommunityPoolStreamProposal      = types.CancelCommunityPoolStreamProposal
+	CommunityPoolStream                    = types.CommunityPoolStream
+	CommunityPoolStreams                   = types.CommunityPoolStreams
+	QueryCommunityPoolStreamParams         = types.QueryCommunityPoolStreamParams
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
+	QueryValidatorMissedRewardsParams      = types.QueryValidatorMissedRewardsParams
+	QueryValidatorAPRParams                = types.QueryValidatorAPRParams
+	QueryEstimatedRewardsParams            = types.QueryEstimatedRewardsParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
+	QueryDelegatorAutoRestakeParams        = types.QueryDelegatorAutoRestakeParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
+	QueryDelegatorAutoRestakeResponse      = types.QueryDelegatorAutoRestakeResponse
+	QueryNetworkAPRResponse                = types.QueryNetworkAPRResponse
+	QueryValidatorAPRResponse              = types.QueryValidatorAPRResponse
+	AutoRestakeDelegators                  = types.AutoRestakeDelegators
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
@@ -164,4 +246,5 @@ type (
 	ValidatorSlashEvent                    = types.ValidatorSlashEvent
 	ValidatorSlashEvents                   = types.ValidatorSlashEvents
 	ValidatorOutstandingRewards            = types.ValidatorOutstandingRewards
+	ValidatorMissedRewards                 = types.ValidatorMissedRewards
 )
@@ -29,10 +29,15 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	distQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryValidatorOutstandingRewards(queryRoute, cdc),
+		GetCmdQueryValidatorMissedRewards(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryCommunityPoolStreams(queryRoute, cdc),
+		GetCmdQueryAPR(queryRoute, cdc),
+		GetCmdQueryEstimatedRewards(queryRoute, cdc),
+		GetCmdQueryAutoRestake(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -103,6 +108,53 @@ $ %s query distr validator-outstanding-rewards cosmosvaloper1lwjmdnks33xwnmfayc6
 	}
 }
 
+// GetCmdQueryValidatorMissedRewards implements the query validator missed rewards command.
+func GetCmdQueryValidatorMissedRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-missed-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the total rewards a validator missed by not signing blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total rewards a validator did not receive because it
+missed the vote of blocks, as set by the missed vote rewards recipient parameter.
+
+Example:
+$ %s query distr validator-missed-rewards cosmosvaloper1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorMissedRewardsParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			resp, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorMissedRewards),
+				bz,
+			)
+			if err != nil {
+				return err
+			}
+
+			var missedRewards types.ValidatorMissedRewards
+			if err := cdc.UnmarshalJSON(resp, &missedRewards); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(missedRewards)
+		},
+	}
+}
+
 // GetCmdQueryValidatorCommission implements the query validator commission command.
 func GetCmdQueryValidatorCommission(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -262,3 +314,207 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryCommunityPoolStreams implements the query community pool streams command.
+func GetCmdQueryCommunityPoolStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "community-pool-streams [<stream-id>]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query a community pool stream, or all of them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a stream paying an amount from the community pool over time.
+Without a stream ID, list all the community pool streams.
+
+Example:
+$ %s query distr community-pool-streams 1
+$ %s query distr community-pool-streams
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStreams)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var streams types.CommunityPoolStreams
+				cdc.MustUnmarshalJSON(res, &streams)
+				return cliCtx.PrintOutput(streams)
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream ID %s not a valid uint, please input a valid stream ID", args[0])
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStream)
+			bz := cdc.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID))
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var stream types.CommunityPoolStream
+			cdc.MustUnmarshalJSON(res, &stream)
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
+
+// GetCmdQueryAPR implements the query APR command.
+func GetCmdQueryAPR(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "apr [<validator>]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the estimated nominal APR of the network or of the delegators of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the estimated nominal annual percentage rate of the staking rewards,
+from the current annual provisions, the bonded tokens, the community tax and the
+proposer reward parameters. Fees are not taken into account. With a validator
+address, query the APR of its delegators after commission.
+
+Example:
+$ %s query distr apr
+$ %s query distr apr cosmosvaloper1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryNetworkAPR)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var apr types.QueryNetworkAPRResponse
+				cdc.MustUnmarshalJSON(res, &apr)
+				return cliCtx.PrintOutput(apr)
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorAPR)
+			bz := cdc.MustMarshalJSON(types.NewQueryValidatorAPRParams(valAddr))
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var apr types.QueryValidatorAPRResponse
+			cdc.MustUnmarshalJSON(res, &apr)
+			return cliCtx.PrintOutput(apr)
+		},
+	}
+}
+
+// GetCmdQueryEstimatedRewards implements the query estimated rewards command.
+func GetCmdQueryEstimatedRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "estimated-rewards [validator] [amount] [blocks]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the estimated rewards of a delegation to a validator over a number of blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the estimated rewards of delegating an amount of staking tokens to a
+validator for a number of blocks, from the current APR of the validator's delegators.
+The rewards are not compounded.
+
+Example:
+$ %s query distr estimated-rewards cosmosvaloper1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne 1000000 100000
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("amount %s not a valid int, please input a valid amount", args[1])
+			}
+
+			blocks, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("blocks %s not a valid int, please input a valid number of blocks", args[2])
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEstimatedRewards)
+			bz := cdc.MustMarshalJSON(types.NewQueryEstimatedRewardsParams(valAddr, amount, blocks))
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var rewards sdk.DecCoins
+			cdc.MustUnmarshalJSON(res, &rewards)
+			return cliCtx.PrintOutput(rewards)
+		},
+	}
+}
+
+// GetCmdQueryAutoRestake implements the query auto restake command.
+func GetCmdQueryAutoRestake(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-restake [<delegator-addr>]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query whether a delegator has auto restaking enabled, or all delegators that have",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards of a delegator are automatically restaked.
+Without a delegator address, list all delegators with auto restaking enabled.
+
+Example:
+$ %s query distr auto-restake cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query distr auto-restake
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoRestakeDelegators)
+				res, _, err := cliCtx.QueryWithData(route, nil)
+				if err != nil {
+					return err
+				}
+
+				var delegators types.AutoRestakeDelegators
+				cdc.MustUnmarshalJSON(res, &delegators)
+				return cliCtx.PrintOutput(delegators)
+			}
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoRestake)
+			bz := cdc.MustMarshalJSON(types.NewQueryDelegatorAutoRestakeParams(delAddr))
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result types.QueryDelegatorAutoRestakeResponse
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
 	"github.com/hyperspeednetwork/hsnhub/x/gov"
+	govcli "github.com/hyperspeednetwork/hsnhub/x/gov/client/cli"
 
 	"github.com/hyperspeednetwork/hsnhub/x/distribution/client/common"
 	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
@@ -46,6 +48,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdSetAutoRestake(cdc),
+		GetCmdFundCommunityPool(cdc),
 	)...)
 
 	return distTxCmd
@@ -195,6 +199,74 @@ $ %s tx set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from m
 	}
 }
 
+// command to enable or disable the automatic restaking of a delegator's rewards
+func GetCmdSetAutoRestake(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-restake [true|false]",
+		Short: "enable or disable the automatic restaking of the rewards of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a delegator.
+The bond denomination rewards of every delegation are periodically withdrawn and
+delegated back to the same validator, as long as the withdraw address is the
+delegator address.
+
+Example:
+$ %s tx distr set-auto-restake true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(cliCtx.GetFromAddress(), enabled)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFundCommunityPool returns a command implementation that supports directly
+// funding the community pool.
+func GetCmdFundCommunityPool(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fund-community-pool [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "funds the community pool with the specified amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Funds the community pool with the specified amount.
+
+Example:
+$ %s tx distr fund-community-pool 100uatom --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundCommunityPool(amount, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -254,3 +326,120 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitStreamProposal implements the command to submit a community-pool-stream proposal
+func GetCmdSubmitStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pay an amount from the community pool to a recipient
+over time, along with an initial deposit. The amount is paid linearly between the
+start and end heights, every interval blocks. The proposal details must be supplied
+via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every day!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": [
+    {
+      "denom": "stake",
+      "amount": "100000"
+    }
+  ],
+  "start_height": "100000",
+  "end_height": "200000",
+  "interval": "14400",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCommunityPoolStreamProposal(proposal.Title, proposal.Description, proposal.Recipient,
+				proposal.Amount, proposal.StartHeight, proposal.EndHeight, proposal.Interval)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelStreamProposal implements the command to submit a cancel-community-pool-stream proposal
+func GetCmdSubmitCancelStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel the remaining payments of a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the remaining payments of a community pool stream
+along with an initial deposit. The amount not paid yet stays in the community pool.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-stream 1 --title="Cancel grant" --description="..." --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream ID %s not a valid uint, please input a valid stream ID", args[0])
+			}
+
+			deposit, err := sdk.ParseCoins(viper.GetString(govcli.FlagDeposit))
+			if err != nil {
+				return err
+			}
+
+			title := viper.GetString(govcli.FlagTitle)
+			description := viper.GetString(govcli.FlagDescription)
+			content := types.NewCancelCommunityPoolStreamProposal(title, description, streamID)
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -16,6 +16,18 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolStreamProposalJSON defines a CommunityPoolStreamProposal with a deposit
+	CommunityPoolStreamProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+		StartHeight int64          `json:"start_height" yaml:"start_height"`
+		EndHeight   int64          `json:"end_height" yaml:"end_height"`
+		Interval    int64          `json:"interval" yaml:"interval"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +45,19 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamProposalJSON reads and parses a CommunityPoolStreamProposalJSON from a file.
+func ParseCommunityPoolStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolStreamProposalJSON, error) {
+	proposal := CommunityPoolStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
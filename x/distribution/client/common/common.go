@@ -35,8 +35,27 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamAutoRestakeInterval)
+	retAutoRestakeInterval, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamMaxAutoRestakeDelegations)
+	retMaxAutoRestakeDelegations, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, types.ParamMissedVoteRewardsRecipient)
+	retMissedVoteRewardsRecipient, _, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(
 		retCommunityTax, retBaseProposerReward, retBonusProposerReward, retWithdrawAddrEnabled,
+		retAutoRestakeInterval, retMaxAutoRestakeDelegations, retMissedVoteRewardsRecipient,
 	), nil
 }
 
@@ -11,15 +11,27 @@ type PrettyParams struct {
 	BaseProposerReward  json.RawMessage `json:"base_proposer_reward"`
 	BonusProposerReward json.RawMessage `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled json.RawMessage `json:"withdraw_addr_enabled"`
+
+	AutoRestakeInterval       json.RawMessage `json:"auto_restake_interval"`
+	MaxAutoRestakeDelegations json.RawMessage `json:"max_auto_restake_delegations"`
+
+	MissedVoteRewardsRecipient json.RawMessage `json:"missed_vote_rewards_recipient"`
 }
 
 // Construct a new PrettyParams
-func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage) PrettyParams {
+func NewPrettyParams(communityTax json.RawMessage, baseProposerReward json.RawMessage, bonusProposerReward json.RawMessage, withdrawAddrEnabled json.RawMessage,
+	autoRestakeInterval json.RawMessage, maxAutoRestakeDelegations json.RawMessage,
+	missedVoteRewardsRecipient json.RawMessage) PrettyParams {
 	return PrettyParams{
 		CommunityTax:        communityTax,
 		BaseProposerReward:  baseProposerReward,
 		BonusProposerReward: bonusProposerReward,
 		WithdrawAddrEnabled: withdrawAddrEnabled,
+
+		AutoRestakeInterval:       autoRestakeInterval,
+		MaxAutoRestakeDelegations: maxAutoRestakeDelegations,
+
+		MissedVoteRewardsRecipient: missedVoteRewardsRecipient,
 	}
 }
 
@@ -28,7 +40,11 @@ func (pp PrettyParams) String() string {
   Community Tax:          %s
   Base Proposer Reward:   %s
   Bonus Proposer Reward:  %s
-  Withdraw Addr Enabled:  %s`, pp.CommunityTax,
-		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled)
+  Withdraw Addr Enabled:  %s
+  Auto Restake Interval:  %s
+  Restakes Per Block:     %s
+  Missed Vote Rewards:    %s`, pp.CommunityTax,
+		pp.BaseProposerReward, pp.BonusProposerReward, pp.WithdrawAddrEnabled,
+		pp.AutoRestakeInterval, pp.MaxAutoRestakeDelegations, pp.MissedVoteRewardsRecipient)
 
 }
@@ -8,5 +8,7 @@ import (
 
 // param change proposal handler
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler             = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	StreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitStreamProposal, rest.StreamProposalRESTHandler)
+	CancelStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelStreamProposal, rest.CancelStreamProposalRESTHandler)
 )
@@ -33,6 +33,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get whether the rewards are automatically restaked
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_restake",
+		delegatorAutoRestakeHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the delegators with auto restaking enabled
+	r.HandleFunc(
+		"/distribution/auto_restake_delegators",
+		autoRestakeDelegatorsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -51,6 +63,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		outstandingRewardsHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Rewards a single validator missed by not signing blocks
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/missed_rewards",
+		missedRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the estimated nominal APR of a validator's delegators
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/apr",
+		validatorAPRHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the estimated rewards of a delegation to a validator
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/estimated_rewards",
+		estimatedRewardsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get the estimated nominal APR of the network
+	r.HandleFunc(
+		"/distribution/apr",
+		networkAPRHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Get the current distribution parameter values
 	r.HandleFunc(
 		"/distribution/parameters",
@@ -63,6 +99,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the community pool streams
+	r.HandleFunc(
+		"/distribution/community_pool/streams",
+		communityPoolStreamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a single community pool stream
+	r.HandleFunc(
+		"/distribution/community_pool/streams/{streamID}",
+		communityPoolStreamHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -126,6 +174,50 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query whether a delegator has auto restaking enabled
+func delegatorAutoRestakeHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorAutoRestakeParams(delegatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoRestake), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the delegators with auto restaking enabled
+func autoRestakeDelegatorsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoRestakeDelegators), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -275,6 +367,112 @@ func outstandingRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) h
 	}
 }
 
+// HTTP request handler to query the rewards a validator missed
+func missedRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bin := cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorMissedRewardsParams(validatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorMissedRewards), bin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the estimated nominal APR of the network
+func networkAPRHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryNetworkAPR), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the estimated nominal APR of a validator's delegators
+func validatorAPRHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bin := cliCtx.Codec.MustMarshalJSON(types.NewQueryValidatorAPRParams(validatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorAPR), bin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the estimated rewards of a delegation of the
+// amount to a validator over the number of blocks given as query parameters
+func estimatedRewardsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		amount, ok := sdk.NewIntFromString(r.FormValue("amount"))
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid amount")
+			return
+		}
+
+		blocks, ok := rest.ParseInt64OrReturnBadRequest(w, r.FormValue("blocks"))
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bin := cliCtx.Codec.MustMarshalJSON(types.NewQueryEstimatedRewardsParams(validatorAddr, amount, blocks))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEstimatedRewards), bin)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkResponseQueryDelegatorTotalRewards(
 	w http.ResponseWriter, cliCtx context.CLIContext, queryRoute, delAddr string,
 ) (res []byte, ok bool) {
@@ -300,3 +498,47 @@ func checkResponseQueryDelegationRewards(
 
 	return res, true
 }
+
+// HTTP request handler to query the community pool streams
+func communityPoolStreamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStreams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a single community pool stream
+func communityPoolStreamHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["streamID"])
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommunityPoolStream), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
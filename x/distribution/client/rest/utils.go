@@ -17,4 +17,30 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolStreamProposalReq defines a community pool stream proposal request body.
+	CommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+		StartHeight int64          `json:"start_height" yaml:"start_height"`
+		EndHeight   int64          `json:"end_height" yaml:"end_height"`
+		Interval    int64          `json:"interval" yaml:"interval"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelCommunityPoolStreamProposalReq defines a cancel community pool stream proposal request body.
+	CancelCommunityPoolStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StreamID    uint64         `json:"stream_id" yaml:"stream_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -16,6 +16,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	keeper.SetBaseProposerReward(ctx, data.BaseProposerReward)
 	keeper.SetBonusProposerReward(ctx, data.BonusProposerReward)
 	keeper.SetWithdrawAddrEnabled(ctx, data.WithdrawAddrEnabled)
+	keeper.SetAutoRestakeInterval(ctx, data.AutoRestakeInterval)
+	keeper.SetMaxAutoRestakeDelegations(ctx, data.MaxAutoRestakeDelegations)
+	keeper.SetMissedVoteRewardsRecipient(ctx, data.MissedVoteRewardsRecipient)
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddress, dwi.WithdrawAddress)
@@ -40,6 +43,19 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, del := range data.AutoRestakeDelegators {
+		keeper.SetDelegatorAutoRestake(ctx, del, true)
+	}
+	if !data.AutoRestakeCursor.Empty() {
+		keeper.SetAutoRestakeCursor(ctx, data.AutoRestakeCursor)
+	}
+	for _, missed := range data.ValidatorMissedRewards {
+		keeper.SetValidatorMissedRewards(ctx, missed.ValidatorAddress, missed.MissedRewards)
+	}
+	for _, stream := range data.CommunityPoolStreams {
+		keeper.SetCommunityPoolStream(ctx, stream)
+	}
+	keeper.SetNextCommunityPoolStreamID(ctx, data.NextCommunityPoolStreamID)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -65,6 +81,9 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	baseProposerRewards := keeper.GetBaseProposerReward(ctx)
 	bonusProposerRewards := keeper.GetBonusProposerReward(ctx)
 	withdrawAddrEnabled := keeper.GetWithdrawAddrEnabled(ctx)
+	autoRestakeInterval := keeper.GetAutoRestakeInterval(ctx)
+	maxAutoRestakeDelegations := keeper.GetMaxAutoRestakeDelegations(ctx)
+	missedVoteRewardsRecipient := keeper.GetMissedVoteRewardsRecipient(ctx)
 	dwi := make([]types.DelegatorWithdrawInfo, 0)
 	keeper.IterateDelegatorWithdrawAddrs(ctx, func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool) {
 		dwi = append(dwi, types.DelegatorWithdrawInfo{
@@ -138,6 +157,29 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 			return false
 		},
 	)
+	autoRestakes := make([]sdk.AccAddress, 0)
+	keeper.IterateDelegatorAutoRestakes(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoRestakes = append(autoRestakes, del)
+		return false
+	})
+	autoRestakeCursor, _ := keeper.GetAutoRestakeCursor(ctx)
+	missed := make([]types.ValidatorMissedRewardsRecord, 0)
+	keeper.IterateValidatorMissedRewards(ctx,
+		func(val sdk.ValAddress, rewards types.ValidatorMissedRewards) (stop bool) {
+			missed = append(missed, types.ValidatorMissedRewardsRecord{
+				ValidatorAddress: val,
+				MissedRewards:    rewards,
+			})
+			return false
+		},
+	)
+	streams := make([]types.CommunityPoolStream, 0)
+	keeper.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	nextStreamID := keeper.GetNextCommunityPoolStreamID(ctx)
 	return types.NewGenesisState(feePool, communityTax, baseProposerRewards, bonusProposerRewards, withdrawAddrEnabled,
-		dwi, pp, outstanding, acc, his, cur, dels, slashes)
+		autoRestakeInterval, maxAutoRestakeDelegations, missedVoteRewardsRecipient, dwi, pp, outstanding, acc, his, cur,
+		dels, slashes, autoRestakes, autoRestakeCursor, missed, streams, nextStreamID)
 }
@@ -23,6 +23,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
 
+		case types.MsgSetAutoRestake:
+			return handleMsgSetAutoRestake(ctx, msg, k)
+
+		case types.MsgFundCommunityPool:
+			return handleMsgFundCommunityPool(ctx, msg, k)
+
+		case types.MsgCommunityPoolSpend:
+			return handleMsgCommunityPoolSpend(ctx, msg, k)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized distribution message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -83,12 +92,68 @@ func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdraw
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgSetAutoRestake(ctx sdk.Context, msg types.MsgSetAutoRestake, k keeper.Keeper) sdk.Result {
+	k.SetDelegatorAutoRestake(ctx, msg.DelegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgFundCommunityPool(ctx sdk.Context, msg types.MsgFundCommunityPool, k keeper.Keeper) sdk.Result {
+	if err := k.FundCommunityPool(ctx, msg.Amount, msg.Depositor); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCommunityPoolSpend(ctx sdk.Context, msg types.MsgCommunityPoolSpend, k keeper.Keeper) sdk.Result {
+	if err := k.SpendCommunityPool(ctx, msg.Authority, msg.Recipient, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
 		switch c := content.(type) {
 		case types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case types.CommunityPoolStreamProposal:
+			return keeper.HandleCommunityPoolStreamProposal(ctx, k, c)
+
+		case types.CancelCommunityPoolStreamProposal:
+			return keeper.HandleCancelCommunityPoolStreamProposal(ctx, k, c)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized distr proposal content type: %T", c)
 			return sdk.ErrUnknownRequest(errMsg)
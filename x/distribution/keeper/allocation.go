@@ -81,15 +81,48 @@ func (k Keeper) AllocateTokens(
 	communityTax := k.GetCommunityTax(ctx)
 	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
 
+	// the rewards of the validators that missed the vote of the previous
+	// block are left to the community pool or shared by the signers
+	var redirectMissedVotes bool
+	rewardedPower := totalPreviousPower
+	switch k.GetMissedVoteRewardsRecipient(ctx) {
+	case types.MissedVoteRewardsRecipientNone:
+		redirectMissedVotes = false
+
+	case types.MissedVoteRewardsRecipientCommunityPool:
+		redirectMissedVotes = true
+
+	case types.MissedVoteRewardsRecipientSigners:
+		redirectMissedVotes = true
+		if sumPreviousPrecommitPower > 0 {
+			rewardedPower = sumPreviousPrecommitPower
+		}
+
+	default:
+		// parameter changes are not validated, unknown values such as a
+		// typo or an empty string are treated as none
+		redirectMissedVotes = false
+	}
+
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/hyperspeednetwork/hsnhub/pull/3099#discussion_r246276376
 	for _, vote := range previousVotes {
 		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 
-		// TODO consider microslashing for missing votes.
-		// ref https://github.com/hyperspeednetwork/hsnhub/issues/2525#issuecomment-430838701
+		// NOTE: the vote power is the power reported to Tendermint, which
+		// staking clips according to its MaxVotingPowerFraction parameter.
 		powerFraction := sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(totalPreviousPower))
 		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
+
+		if !vote.SignedLastBlock && redirectMissedVotes {
+			k.addValidatorMissedRewards(ctx, validator, reward)
+			continue
+		}
+
+		if rewardedPower != totalPreviousPower {
+			powerFraction = sdk.NewDec(vote.Validator.Power).QuoTruncate(sdk.NewDec(rewardedPower))
+			reward = feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
+		}
 		k.AllocateTokensToValidator(ctx, validator, reward)
 		remaining = remaining.Sub(reward)
 	}
@@ -134,3 +167,21 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val exported.Validato
 	outstanding = outstanding.Add(tokens)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
 }
+
+// addValidatorMissedRewards records the rewards a validator did not receive
+// because it missed the vote of the previous block
+func (k Keeper) addValidatorMissedRewards(ctx sdk.Context, val exported.ValidatorI, rewards sdk.DecCoins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMissedRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator().String()),
+		),
+	)
+
+	if rewards.IsZero() {
+		return
+	}
+	missed := k.GetValidatorMissedRewards(ctx, val.GetOperator())
+	k.SetValidatorMissedRewards(ctx, val.GetOperator(), missed.Add(rewards))
+}
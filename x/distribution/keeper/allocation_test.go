@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
 )
 
@@ -184,3 +185,65 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr2).IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr3).IsValid())
 }
+
+func TestAllocateTokensMissedVote(t *testing.T) {
+	tests := []struct {
+		recipient     string
+		rewardsA      sdk.Dec
+		rewardsB      sdk.Dec
+		communityPool sdk.Dec
+	}{
+		// proposer reward (1% + 4% * 0.5) * 100 = 3, and 95% * 100 / 2 = 47.5
+		// of the missed rewards go to the community pool on top of the 2% tax
+		{types.MissedVoteRewardsRecipientCommunityPool, sdk.ZeroDec(), sdk.NewDecWithPrec(505, 1), sdk.NewDecWithPrec(495, 1)},
+		// the signer receives the whole 95% voter share on top of its proposer reward
+		{types.MissedVoteRewardsRecipientSigners, sdk.ZeroDec(), sdk.NewDec(98), sdk.NewDec(2)},
+		// the validator missing the vote keeps its rewards
+		{types.MissedVoteRewardsRecipientNone, sdk.NewDecWithPrec(475, 1), sdk.NewDecWithPrec(505, 1), sdk.NewDec(2)},
+		// unknown values are treated as none
+		{"", sdk.NewDecWithPrec(475, 1), sdk.NewDecWithPrec(505, 1), sdk.NewDec(2)},
+		{"community-pool", sdk.NewDecWithPrec(475, 1), sdk.NewDecWithPrec(505, 1), sdk.NewDec(2)},
+	}
+
+	for _, tc := range tests {
+		ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+		sh := staking.NewHandler(sk)
+		k.SetMissedVoteRewardsRecipient(ctx, tc.recipient)
+
+		// create two validators with 0% commission
+		commission := staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+		msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+		require.True(t, sh(ctx, msg).IsOK())
+		msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+		require.True(t, sh(ctx, msg).IsOK())
+
+		fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+		feeCollector := supplyKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+		require.NoError(t, feeCollector.SetCoins(fees))
+		ak.SetAccount(ctx, feeCollector)
+
+		// allocate tokens as if the first validator had missed the vote and second was proposer
+		votes := []abci.VoteInfo{
+			{
+				Validator:       abci.Validator{Address: valConsPk1.Address(), Power: 100},
+				SignedLastBlock: false,
+			},
+			{
+				Validator:       abci.Validator{Address: valConsPk2.Address(), Power: 100},
+				SignedLastBlock: true,
+			},
+		}
+		k.AllocateTokens(ctx, 100, 200, valConsAddr2, votes)
+
+		require.True(t, tc.rewardsA.Equal(k.GetValidatorOutstandingRewards(ctx, valOpAddr1).AmountOf(sdk.DefaultBondDenom)), tc.recipient)
+		require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, tc.rewardsB}}, k.GetValidatorOutstandingRewards(ctx, valOpAddr2), tc.recipient)
+		require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, tc.communityPool}}, k.GetFeePool(ctx).CommunityPool, tc.recipient)
+
+		// the missed rewards are tracked per validator
+		missed := sdk.NewDecWithPrec(475, 1).Sub(tc.rewardsA)
+		require.True(t, missed.Equal(k.GetValidatorMissedRewards(ctx, valOpAddr1).AmountOf(sdk.DefaultBondDenom)), tc.recipient)
+		require.True(t, k.GetValidatorMissedRewards(ctx, valOpAddr2).IsZero(), tc.recipient)
+	}
+}
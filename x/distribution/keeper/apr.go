@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
+	stakingexported "github.com/hyperspeednetwork/hsnhub/x/staking/exported"
+)
+
+// GetNetworkAPR returns the estimated nominal APR of the staking rewards,
+// from the annual provisions of the mint module and the bonded tokens. The
+// validators are assumed to sign every block, so that the proposer rewards
+// are earned in proportion to the voting power like the vote rewards and no
+// rewards are redirected for missed votes. Fees are not taken into account.
+func (k Keeper) GetNetworkAPR(ctx sdk.Context) types.QueryNetworkAPRResponse {
+	provisions := k.mintKeeper.GetMinter(ctx).AnnualProvisions
+	bondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	if !bondedTokens.IsPositive() {
+		return types.NewQueryNetworkAPRResponse(provisions, bondedTokens, sdk.ZeroDec(), sdk.ZeroDec())
+	}
+
+	// same split of the provisions as in AllocateTokens
+	rate := provisions.QuoInt(bondedTokens)
+	proposerMultiplier := k.GetBaseProposerReward(ctx).Add(k.GetBonusProposerReward(ctx))
+	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(k.GetCommunityTax(ctx))
+
+	return types.NewQueryNetworkAPRResponse(provisions, bondedTokens,
+		rate.Mul(voteMultiplier), rate.Mul(proposerMultiplier))
+}
+
+// GetValidatorAPR returns the estimated nominal APR of the delegators of a
+// validator, after commission. Validators that are not bonded earn no rewards.
+//
+// The rewards are allocated by the power reported to Tendermint, which staking
+// clips according to its MaxVotingPowerFraction parameter, so the network APR
+// is scaled by the ratio of the validator's share of the last total power to
+// its share of the bonded tokens.
+func (k Keeper) GetValidatorAPR(ctx sdk.Context, val stakingexported.ValidatorI) types.QueryValidatorAPRResponse {
+	apr := sdk.ZeroDec()
+	if val.IsBonded() {
+		apr = k.GetNetworkAPR(ctx).APR.Mul(sdk.OneDec().Sub(val.GetCommission()))
+
+		lastTotalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+		if lastTotalPower.IsPositive() && val.GetTokens().IsPositive() {
+			lastPower := k.stakingKeeper.GetLastValidatorPower(ctx, val.GetOperator())
+			apr = apr.MulInt64(lastPower).MulInt(k.stakingKeeper.TotalBondedTokens(ctx)).
+				QuoInt(lastTotalPower).QuoInt(val.GetTokens())
+		}
+	}
+	return types.NewQueryValidatorAPRResponse(val.GetOperator(), val.GetCommission(), apr)
+}
+
+// EstimateDelegationRewards returns the estimated rewards of a delegation of
+// the given amount to a validator over a number of blocks, at the block rate
+// of the mint module. The rewards are not compounded. An error is returned if
+// the estimate is too large to be represented as a decimal.
+func (k Keeper) EstimateDelegationRewards(ctx sdk.Context, val stakingexported.ValidatorI,
+	amount sdk.Int, blocks int64) (sdk.DecCoins, sdk.Error) {
+
+	mintParams := k.mintKeeper.GetParams(ctx)
+	if mintParams.BlocksPerYear == 0 {
+		return sdk.DecCoins{}, nil
+	}
+
+	// the product is computed on big integers, as the decimal operations
+	// panic on overflow
+	apr := k.GetValidatorAPR(ctx, val).APR
+	rewards := new(big.Int).Mul(apr.Int, amount.BigInt())
+	rewards.Mul(rewards, big.NewInt(blocks))
+	rewards.Quo(rewards, new(big.Int).SetUint64(mintParams.BlocksPerYear))
+	if rewards.BitLen() > 255+sdk.DecimalPrecisionBits {
+		return nil, types.ErrEstimateOverflow(k.codespace)
+	}
+
+	return sdk.DecCoins{sdk.NewDecCoinFromDec(mintParams.MintDenom, sdk.NewDecFromBigIntWithPrec(rewards, sdk.Precision))}, nil
+}
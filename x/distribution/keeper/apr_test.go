@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking"
+)
+
+func TestEstimateRewards(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// nothing is bonded yet
+	require.True(t, k.GetNetworkAPR(ctx).APR.IsZero())
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// the validator earns no rewards until it is bonded
+	val := sk.Validator(ctx, valOpAddr1)
+	require.True(t, k.GetValidatorAPR(ctx, val).APR.IsZero())
+	staking.EndBlocker(ctx, sk)
+	val = sk.Validator(ctx, valOpAddr1)
+
+	// 13% of the 6000 tokens supply are minted per year for 100 bonded
+	// tokens, 2% community tax, 1% base and 4% bonus proposer rewards
+	apr := k.GetNetworkAPR(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(7254, 3), apr.VoteAPR)
+	require.Equal(t, sdk.NewDecWithPrec(39, 2), apr.ProposerAPR)
+	require.Equal(t, sdk.NewDecWithPrec(7644, 3), apr.APR)
+
+	valAPR := k.GetValidatorAPR(ctx, val)
+	require.Equal(t, valOpAddr1, valAPR.ValidatorAddress)
+	require.Equal(t, sdk.NewDecWithPrec(3822, 3), valAPR.APR)
+
+	// half a year of rewards of a 1000 tokens delegation
+	blocks := int64(k.mintKeeper.GetParams(ctx).BlocksPerYear / 2)
+	expected := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1911))}
+	rewards, err := k.EstimateDelegationRewards(ctx, val, sdk.NewInt(1000), blocks)
+	require.NoError(t, err)
+	require.Equal(t, expected, rewards)
+
+	// estimates too large to be represented are rejected
+	maxInt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)))
+	_, err = k.EstimateDelegationRewards(ctx, val, maxInt, math.MaxInt64)
+	require.Error(t, err)
+}
+
+func TestValidatorAPRPowerCap(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	params := sk.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(5, 1)
+	sk.SetParams(ctx, params)
+
+	// the power of the first validator is clipped to the power of the second
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(300)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	msg = staking.NewMsgCreateValidator(valOpAddr2, valConsPk2,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+	require.Equal(t, int64(100), sk.GetLastValidatorPower(ctx, valOpAddr1))
+
+	// each validator earns half of the rewards, with a quarter and three
+	// quarters of the bonded tokens
+	networkAPR := k.GetNetworkAPR(ctx).APR
+	apr1 := k.GetValidatorAPR(ctx, sk.Validator(ctx, valOpAddr1)).APR
+	apr2 := k.GetValidatorAPR(ctx, sk.Validator(ctx, valOpAddr2)).APR
+	require.Equal(t, networkAPR.MulInt64(2).QuoInt64(3), apr1)
+	require.Equal(t, networkAPR.MulInt64(2), apr2)
+}
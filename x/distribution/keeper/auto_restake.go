@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking/exported"
+)
+
+// ProcessAutoRestakes restakes the rewards of the delegators with auto
+// restaking enabled. A round starts every AutoRestakeInterval blocks and
+// restakes at most MaxAutoRestakeDelegations delegations per block, the round
+// continues in the following blocks after the last delegation restaked until
+// every delegation was processed. A delegator with no delegation to restake
+// counts as one delegation, so that the work done per block stays bounded.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	interval := k.GetAutoRestakeInterval(ctx)
+	if interval <= 0 {
+		return
+	}
+
+	cursor, inRound := k.GetAutoRestakeCursor(ctx)
+	if !inRound && ctx.BlockHeight()%interval != 0 {
+		return
+	}
+
+	maxDelegations := k.GetMaxAutoRestakeDelegations(ctx)
+	if maxDelegations == 0 {
+		return
+	}
+
+	// resume the round with the next delegations of the last delegator, or
+	// after it if all of its delegations were processed
+	store := ctx.KVStore(k.storeKey)
+	start := DelegatorAutoRestakePrefix
+	if inRound {
+		start = GetDelegatorAutoRestakeKey(cursor.DelegatorAddress)
+		if cursor.ValidatorAddress.Empty() {
+			start = append(start, 0x00)
+		}
+	}
+	iter := store.Iterator(start, sdk.PrefixEndBytes(DelegatorAutoRestakePrefix))
+	defer iter.Close()
+
+	remaining := maxDelegations
+	var lastDelAddr sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		if remaining == 0 {
+			k.SetAutoRestakeCursor(ctx, types.NewAutoRestakeCursor(lastDelAddr, nil))
+			return
+		}
+
+		delAddr := GetDelegatorAutoRestakeAddress(iter.Key())
+		var after sdk.ValAddress
+		if inRound && delAddr.Equals(cursor.DelegatorAddress) {
+			after = cursor.ValidatorAddress
+		}
+
+		valAddr, restaked, done := k.autoRestake(ctx, delAddr, after, remaining)
+		if !done {
+			k.SetAutoRestakeCursor(ctx, types.NewAutoRestakeCursor(delAddr, valAddr))
+			return
+		}
+
+		if restaked == 0 {
+			restaked = 1
+		}
+		remaining -= restaked
+		lastDelAddr = delAddr
+	}
+
+	k.DeleteAutoRestakeCursor(ctx)
+}
+
+// autoRestake withdraws the rewards of at most maxDelegations delegations of
+// the delegator, to the validators after the given one, and delegates the bond
+// denomination part back to the same validator. It returns the validator of
+// the last delegation processed, the number of delegations processed and
+// whether all of the delegations of the delegator were processed. Rewards
+// sent to a withdraw address other than the delegator are not restaked.
+func (k Keeper) autoRestake(ctx sdk.Context, delAddr sdk.AccAddress, after sdk.ValAddress,
+	maxDelegations uint32) (last sdk.ValAddress, processed uint32, done bool) {
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil, 0, true
+	}
+
+	// one more delegation is read to know whether any is left afterwards
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegationsAfter(ctx, delAddr, after, func(_ int64, del exported.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return uint32(len(valAddrs)) > maxDelegations
+	})
+	done = uint32(len(valAddrs)) <= maxDelegations
+	if !done {
+		valAddrs = valAddrs[:maxDelegations]
+	}
+	if len(valAddrs) == 0 {
+		return nil, 0, true
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			continue
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		// a failed delegation leaves the withdrawn rewards with the delegator
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.stakingKeeper.ValidateVotingPowerSoftLimit(cacheCtx, validator, amount, sdk.Unbonded); err != nil {
+			continue
+		}
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+			continue
+		}
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRestake,
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			),
+		)
+	}
+	return valAddrs[len(valAddrs)-1], uint32(len(valAddrs)), done
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking"
+)
+
+func TestProcessAutoRestakes(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with no commission
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// delegate to the validator from two other accounts
+	delAddrs := []sdk.AccAddress{delAddr1, delAddr2}
+	if bytes.Compare(delAddrs[0], delAddrs[1]) > 0 {
+		delAddrs[0], delAddrs[1] = delAddrs[1], delAddrs[0]
+	}
+	for _, delAddr := range delAddrs {
+		msg := staking.NewMsgDelegate(delAddr, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+		require.True(t, sh(ctx, msg).IsOK())
+	}
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// restake a single delegation per block every 10 blocks
+	k.SetAutoRestakeInterval(ctx, 10)
+	k.SetMaxAutoRestakeDelegations(ctx, 1)
+	for _, delAddr := range delAddrs {
+		k.SetDelegatorAutoRestake(ctx, delAddr, true)
+	}
+	require.True(t, k.GetDelegatorAutoRestake(ctx, delAddrs[0]))
+	require.False(t, k.GetDelegatorAutoRestake(ctx, sdk.AccAddress(valOpAddr1)))
+
+	// allocate some rewards, funding the distribution module account
+	tokens := sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDec(300)}}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		return sk.Delegation(ctx, delAddr, valOpAddr1).GetShares()
+	}
+
+	// nothing happens outside of a round
+	ctx = ctx.WithBlockHeight(9)
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(100), shares(delAddrs[0]))
+	require.Equal(t, sdk.NewDec(100), shares(delAddrs[1]))
+
+	// the first delegator is restaked when the round starts
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(delAddrs[0]))
+	require.Equal(t, sdk.NewDec(100), shares(delAddrs[1]))
+	cursor, found := k.GetAutoRestakeCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delAddrs[0], cursor.DelegatorAddress)
+	require.True(t, cursor.ValidatorAddress.Empty())
+
+	// the round continues with the second delegator in the next block
+	ctx = ctx.WithBlockHeight(11)
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(delAddrs[0]))
+	require.Equal(t, sdk.NewDec(200), shares(delAddrs[1]))
+	_, found = k.GetAutoRestakeCursor(ctx)
+	require.False(t, found)
+
+	// the validator rewards are not restaked
+	require.Equal(t, sdk.NewDec(100), shares(sdk.AccAddress(valOpAddr1)))
+}
+
+func TestProcessAutoRestakesWithdrawAddr(t *testing.T) {
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with no commission
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// rewards sent to another withdraw address are not restaked
+	valAccAddr := sdk.AccAddress(valOpAddr1)
+	k.SetAutoRestakeInterval(ctx, 1)
+	k.SetMaxAutoRestakeDelegations(ctx, 10)
+	k.SetDelegatorAutoRestake(ctx, valAccAddr, true)
+	k.SetDelegatorWithdrawAddr(ctx, valAccAddr, delAddr1)
+
+	tokens := sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDec(100)}}
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), tokens)
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(100), sk.Delegation(ctx, valAccAddr, valOpAddr1).GetShares())
+	require.Equal(t, balance, ak.GetAccount(ctx, delAddr1).GetCoins())
+
+	// disabling auto restaking removes the delegator
+	k.SetDelegatorAutoRestake(ctx, valAccAddr, false)
+	require.False(t, k.GetDelegatorAutoRestake(ctx, valAccAddr))
+}
+
+func TestProcessAutoRestakesDelegations(t *testing.T) {
+	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create two validators with no commission
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	valAddrs := []sdk.ValAddress{valOpAddr1, valOpAddr2}
+	if bytes.Compare(valAddrs[0], valAddrs[1]) > 0 {
+		valAddrs[0], valAddrs[1] = valAddrs[1], valAddrs[0]
+	}
+	for i, pk := range []crypto.PubKey{valConsPk1, valConsPk2} {
+		msg := staking.NewMsgCreateValidator([]sdk.ValAddress{valOpAddr1, valOpAddr2}[i], pk,
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+		require.True(t, sh(ctx, msg).IsOK())
+	}
+
+	// a single delegator delegates to both validators
+	for _, valAddr := range valAddrs {
+		msg := staking.NewMsgDelegate(delAddr1, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+		require.True(t, sh(ctx, msg).IsOK())
+	}
+	staking.EndBlocker(ctx, sk)
+
+	k.SetAutoRestakeInterval(ctx, 10)
+	k.SetMaxAutoRestakeDelegations(ctx, 1)
+	k.SetDelegatorAutoRestake(ctx, delAddr1, true)
+
+	// allocate some rewards, funding the distribution module account
+	tokens := sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDec(200)}}
+	for _, valAddr := range valAddrs {
+		k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valAddr), tokens)
+	}
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(400)))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	shares := func(valAddr sdk.ValAddress) sdk.Dec {
+		return sk.Delegation(ctx, delAddr1, valAddr).GetShares()
+	}
+
+	// the budget applies to the delegations of a single delegator
+	ctx = ctx.WithBlockHeight(10)
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(valAddrs[0]))
+	require.Equal(t, sdk.NewDec(100), shares(valAddrs[1]))
+	cursor, found := k.GetAutoRestakeCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delAddr1, cursor.DelegatorAddress)
+	require.Equal(t, valAddrs[0], cursor.ValidatorAddress)
+
+	// the round resumes with the next delegation of the delegator
+	ctx = ctx.WithBlockHeight(11)
+	k.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(valAddrs[0]))
+	require.Equal(t, sdk.NewDec(200), shares(valAddrs[1]))
+	_, found = k.GetAutoRestakeCursor(ctx)
+	require.False(t, found)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
+)
+
+// CreateCommunityPoolStream creates a stream paying the amount from the
+// community pool to the recipient between the start and end heights. The
+// amount is not reserved, each payment is made from the community pool
+// balance at the time it is due.
+func (k Keeper) CreateCommunityPoolStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight, interval int64) (uint64, sdk.Error) {
+
+	if k.blacklistedAddrs[recipient.String()] {
+		return 0, sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", recipient))
+	}
+	if endHeight <= ctx.BlockHeight() {
+		return 0, types.ErrInvalidCommunityPoolStream(k.codespace,
+			fmt.Sprintf("end height %d is not after the current height %d", endHeight, ctx.BlockHeight()))
+	}
+
+	id := k.GetNextCommunityPoolStreamID(ctx)
+	k.SetCommunityPoolStream(ctx, types.NewCommunityPoolStream(id, recipient, amount, startHeight, endHeight, interval))
+	k.SetNextCommunityPoolStreamID(ctx, id+1)
+	return id, nil
+}
+
+// CancelCommunityPoolStream removes a stream, its remaining amount stays in
+// the community pool
+func (k Keeper) CancelCommunityPoolStream(ctx sdk.Context, id uint64) sdk.Error {
+	if _, found := k.GetCommunityPoolStream(ctx, id); !found {
+		return types.ErrUnknownCommunityPoolStream(k.codespace, id)
+	}
+
+	k.DeleteCommunityPoolStream(ctx, id)
+	return nil
+}
+
+// ProcessCommunityPoolStreams pays the amounts due by the community pool
+// streams at the current height. A payment that the community pool cannot
+// cover is postponed to the next payment height of the stream. Streams are
+// removed once fully paid.
+func (k Keeper) ProcessCommunityPoolStreams(ctx sdk.Context) {
+	var streams []types.CommunityPoolStream
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		if stream.IsPaymentHeight(ctx.BlockHeight()) {
+			streams = append(streams, stream)
+		}
+		return false
+	})
+
+	for _, stream := range streams {
+		k.payCommunityPoolStream(ctx, stream)
+	}
+}
+
+func (k Keeper) payCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	due := stream.Due(ctx.BlockHeight())
+	if !due.IsZero() {
+		err := k.DistributeFromFeePool(ctx, due, stream.Recipient)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("postponed payment of %s for community pool stream %d: %s",
+				due, stream.ID, err))
+			return
+		}
+
+		stream.Paid = stream.Paid.Add(due)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStreamPayment,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, due.String()),
+			),
+		)
+	}
+
+	if stream.IsCompleted() {
+		k.DeleteCommunityPoolStream(ctx, stream.ID)
+		return
+	}
+	k.SetCommunityPoolStream(ctx, stream)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestProcessCommunityPoolStreams(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+
+	// the end height must be in the future
+	ctx = ctx.WithBlockHeight(20)
+	_, err := keeper.CreateCommunityPoolStream(ctx, delAddr2, amount, 10, 20, 5)
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(1)
+	id, err := keeper.CreateCommunityPoolStream(ctx, delAddr2, amount, 10, 20, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), keeper.GetNextCommunityPoolStreamID(ctx))
+
+	balance := ak.GetAccount(ctx, delAddr2).GetCoins()
+
+	// nothing is paid before the start height or between payment heights
+	for _, height := range []int64{10, 12} {
+		keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(height))
+		require.Equal(t, balance, ak.GetAccount(ctx, delAddr2).GetCoins())
+	}
+
+	// half of the amount is streamed at the middle of the period
+	half := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+	keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(15))
+	require.Equal(t, balance.Add(half), ak.GetAccount(ctx, delAddr2).GetCoins())
+	stream, found := keeper.GetCommunityPoolStream(ctx, id)
+	require.True(t, found)
+	require.Equal(t, half, stream.Paid)
+
+	// the rest is paid at the end height and the stream is removed
+	keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(20))
+	require.Equal(t, balance.Add(amount), ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsZero())
+	_, found = keeper.GetCommunityPoolStream(ctx, id)
+	require.False(t, found)
+}
+
+func TestProcessCommunityPoolStreamsPostponed(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	id, err := keeper.CreateCommunityPoolStream(ctx, delAddr2, amount, 10, 20, 5)
+	require.NoError(t, err)
+
+	// the payment is postponed while the community pool is empty
+	balance := ak.GetAccount(ctx, delAddr2).GetCoins()
+	keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(15))
+	require.Equal(t, balance, ak.GetAccount(ctx, delAddr2).GetCoins())
+	stream, found := keeper.GetCommunityPoolStream(ctx, id)
+	require.True(t, found)
+	require.True(t, stream.Paid.IsZero())
+
+	// the postponed amount is paid with the next payment after the end height
+	require.NoError(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+	keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(21))
+	require.Equal(t, balance.Add(amount), ak.GetAccount(ctx, delAddr2).GetCoins())
+	_, found = keeper.GetCommunityPoolStream(ctx, id)
+	require.False(t, found)
+}
+
+func TestCancelCommunityPoolStream(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+	id, err := keeper.CreateCommunityPoolStream(ctx, delAddr2, amount, 10, 20, 5)
+	require.NoError(t, err)
+
+	require.Error(t, keeper.CancelCommunityPoolStream(ctx, id+1))
+	require.NoError(t, keeper.CancelCommunityPoolStream(ctx, id))
+	_, found := keeper.GetCommunityPoolStream(ctx, id)
+	require.False(t, found)
+
+	// the remaining amount stays in the community pool
+	balance := ak.GetAccount(ctx, delAddr2).GetCoins()
+	keeper.ProcessCommunityPoolStreams(ctx.WithBlockHeight(20))
+	require.Equal(t, balance, ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(amount), keeper.GetFeePool(ctx).CommunityPool)
+}
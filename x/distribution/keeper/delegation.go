@@ -7,6 +7,7 @@ import (
 
 	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
 	"github.com/hyperspeednetwork/hsnhub/x/staking/exported"
+	stakingtypes "github.com/hyperspeednetwork/hsnhub/x/staking/types"
 )
 
 // initialize starting info for a new delegation
@@ -160,6 +161,15 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
+	// the share holder of tokenized shares only restakes the bond denomination
+	// for the share token holders, the other rewards go to the community pool
+	if del.GetDelegatorAddr().Equals(stakingtypes.GetTokenizeShareHolderAddress(del.GetValidatorAddr())) {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, coins.AmountOf(bondDenom)))
+		remainder = remainder.Add(sdk.NewDecCoins(coins.Sub(bondCoins)))
+		coins = bondCoins
+	}
+
 	// add coins to user account
 	if !coins.IsZero() {
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
@@ -333,6 +333,76 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawRewardsOfTokenizedShares(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, ak, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	distrAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens), sdk.NewCoin("fee", balanceTokens)))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// tokenize half of the self delegation
+	tokenizeMsg := staking.NewMsgTokenizeShares(
+		sdk.AccAddress(valOpAddr1), valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)),
+	)
+	require.True(t, sh(ctx, tokenizeMsg).IsOK())
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.DecCoins{sdk.NewDecCoin("fee", initial), sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	k.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the share holder delegation earns half of the delegator rewards
+	holderAddr := staking.GetTokenizeShareHolderAddress(valOpAddr1)
+	rewards := k.calculateDelegationRewards(ctx, val, sk.Delegation(ctx, holderAddr, valOpAddr1), k.incrementValidatorPeriod(ctx, val))
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin("fee", initial.QuoRaw(4)), sdk.NewDecCoin(sdk.DefaultBondDenom, initial.QuoRaw(4))}, rewards)
+
+	// redeeming the share tokens restakes the bond denomination rewards of
+	// the tokenized shares and pays the ones of the delegation the shares are
+	// added to
+	shareTokens := sdk.NewCoin(staking.GetShareDenom(valOpAddr1), valTokens.QuoRaw(2))
+	require.True(t, sh(ctx, staking.NewMsgRedeemTokensForShares(sdk.AccAddress(valOpAddr1), shareTokens)).IsOK())
+
+	exp := sdk.NewCoins(
+		sdk.NewCoin("fee", initial.QuoRaw(4)),
+		sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens).Add(initial.QuoRaw(4))),
+	)
+	require.Equal(t, exp, ak.GetAccount(ctx, sdk.AccAddress(valOpAddr1)).GetCoins())
+
+	val = sk.Validator(ctx, valOpAddr1)
+	del := sk.Delegation(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Equal(t, valTokens.Add(initial.QuoRaw(4)), val.TokensFromShares(del.GetShares()).TruncateInt())
+
+	// the other rewards of the tokenized shares go to the community pool
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin("fee", initial.QuoRaw(4))}, k.GetFeePoolCommunityCoins(ctx))
+	require.True(t, ak.GetAccount(ctx, holderAddr).GetCoins().IsZero())
+	require.Nil(t, sk.Delegation(ctx, holderAddr, valOpAddr1))
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctx, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
 )
 
 // DistributeFromFeePool distributes funds from the distribution module account to
@@ -27,3 +30,51 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// FundCommunityPool allows an account to directly fund the community pool
+// from its own balance
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) sdk.Error {
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(amount))
+	k.SetFeePool(ctx, feePool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundCommunityPool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// SpendCommunityPool sends coins of the community pool to a recipient on
+// behalf of the governance module account, which is the only authority
+// allowed to spend the community pool through messages
+func (k Keeper) SpendCommunityPool(ctx sdk.Context, authority, recipient sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	if govAddr := k.supplyKeeper.GetModuleAddress(govtypes.ModuleName); govAddr.Empty() || !authority.Equals(govAddr) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("only the governance module account %s can spend the community pool", govAddr))
+	}
+	if k.blacklistedAddrs[recipient.String()] {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is blacklisted from receiving external funds", recipient))
+	}
+
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolSpend,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+
+	return nil
+}
@@ -73,6 +73,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
+
+	// clear missed rewards
+	h.k.DeleteValidatorMissedRewards(ctx, valAddr)
 }
 
 // increment period
@@ -98,8 +98,16 @@ func CanWithdrawInvariant(k Keeper) sdk.Invariant {
 		})
 
 		broken := len(remaining) > 0 && remaining[0].Amount.LT(sdk.ZeroDec())
+
+		// once every reward is withdrawn, the module account must still hold
+		// the community pool, including the coins deposited into it directly
+		communityPool, _ := k.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+		macc := k.GetDistributionAccount(ctx)
+		broken = broken || !macc.GetCoins().IsAllGTE(communityPool)
+
 		return sdk.FormatInvariant(types.ModuleName, "can withdraw",
-			fmt.Sprintf("remaining coins: %v\n", remaining)), broken
+			fmt.Sprintf("remaining coins: %v\ncommunity pool coins: %v\ndistribution ModuleAccount coins: %v\n",
+				remaining, communityPool, macc.GetCoins())), broken
 	}
 }
 
@@ -134,7 +142,8 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the coins held by the distr ModuleAccount
-// is consistent with the sum of validator outstanding rewards
+// is consistent with the sum of validator outstanding rewards and of the
+// community pool, which includes the coins deposited with MsgFundCommunityPool
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 
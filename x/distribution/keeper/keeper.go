@@ -18,6 +18,7 @@ type Keeper struct {
 	paramSpace    params.Subspace
 	stakingKeeper types.StakingKeeper
 	supplyKeeper  types.SupplyKeeper
+	mintKeeper    types.MintKeeper
 
 	codespace sdk.CodespaceType
 
@@ -28,7 +29,7 @@ type Keeper struct {
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType,
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, mk types.MintKeeper, codespace sdk.CodespaceType,
 	feeCollectorName string, blacklistedAddrs map[string]bool) Keeper {
 
 	// ensure distribution module account is set
@@ -42,6 +43,7 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
 		paramSpace:       paramSpace.WithKeyTable(ParamKeyTable()),
 		stakingKeeper:    sk,
 		supplyKeeper:     supplyKeeper,
+		mintKeeper:       mk,
 		codespace:        codespace,
 		feeCollectorName: feeCollectorName,
 		blacklistedAddrs: blacklistedAddrs,
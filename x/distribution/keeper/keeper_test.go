@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -89,3 +90,49 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestFundCommunityPool(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsZero())
+
+	initPool := keeper.GetFeePool(ctx)
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+	require.NoError(t, keeper.FundCommunityPool(ctx, amount, delAddr1))
+
+	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoins(amount)), keeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, balance.Sub(amount), ak.GetAccount(ctx, delAddr1).GetCoins())
+	require.Equal(t, amount, keeper.GetDistributionAccount(ctx).GetCoins())
+
+	// the invariants still hold with the deposited coins
+	_, broken := ModuleAccountInvariant(keeper)(ctx)
+	require.False(t, broken)
+	_, broken = CanWithdrawInvariant(keeper)(ctx)
+	require.False(t, broken)
+
+	// an account cannot deposit more than its balance
+	require.Error(t, keeper.FundCommunityPool(ctx, balance, delAddr1))
+}
+
+func TestSpendCommunityPool(t *testing.T) {
+	ctx, ak, keeper, _, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	govAddr := supplyKeeper.GetModuleAddress(govtypes.ModuleName)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, keeper.FundCommunityPool(ctx, amount.Add(amount), delAddr1))
+
+	// only the governance module account can spend the community pool
+	require.Error(t, keeper.SpendCommunityPool(ctx, delAddr1, delAddr2, amount))
+
+	// module accounts cannot receive community pool funds
+	require.Error(t, keeper.SpendCommunityPool(ctx, govAddr, keeper.GetDistributionAccount(ctx).GetAddress(), amount))
+
+	balance := ak.GetAccount(ctx, delAddr2).GetCoins()
+	require.NoError(t, keeper.SpendCommunityPool(ctx, govAddr, delAddr2, amount))
+	require.Equal(t, balance.Add(amount), ak.GetAccount(ctx, delAddr2).GetCoins())
+	require.Equal(t, sdk.NewDecCoins(amount), keeper.GetFeePool(ctx).CommunityPool)
+
+	// the community pool cannot be overspent
+	require.Error(t, keeper.SpendCommunityPool(ctx, govAddr, delAddr2, amount.Add(amount)))
+}
@@ -32,6 +32,16 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes>: []byte{} (auto restaking enabled)
+//
+// - 0x0A: sdk.AccAddress (last delegator restaked in the current round)
+//
+// - 0x0B<valAddr_Bytes>: ValidatorMissedRewards
+//
+// - 0x0C<streamID_Bytes>: CommunityPoolStream
+//
+// - 0x0D: uint64 (next community pool stream ID)
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -43,11 +53,21 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoRestakePrefix           = []byte{0x09} // key for delegators with auto restaking enabled
+	AutoRestakeCursorKey                 = []byte{0x0A} // key for the last delegation restaked in the current round
+	ValidatorMissedRewardsPrefix         = []byte{0x0B} // key for rewards missed by validators
+	CommunityPoolStreamPrefix            = []byte{0x0C} // key for community pool streams
+	NextCommunityPoolStreamIDKey         = []byte{0x0D} // key for the next community pool stream ID
 
 	ParamStoreKeyCommunityTax        = []byte("communitytax")
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoRestakeInterval       = []byte("autorestakeinterval")
+	ParamStoreKeyMaxAutoRestakeDelegations = []byte("maxautorestakedelegations")
+
+	ParamStoreKeyMissedVoteRewardsRecipient = []byte("missedvoterewardsrecipient")
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -83,6 +103,15 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delA
 	return
 }
 
+// gets the address from a delegator's auto restake key
+func GetDelegatorAutoRestakeAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
 // gets the address & period from a validator's historical rewards key
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -116,6 +145,24 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// gets the address from a validator's missed rewards key
+func GetValidatorMissedRewardsAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// gets the ID from a community pool stream key
+func GetCommunityPoolStreamID(key []byte) (id uint64) {
+	b := key[1:]
+	if len(b) != 8 {
+		panic("unexpected key length")
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
 // gets the height from a validator's slash event key
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -139,6 +186,11 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegator's auto restake setting
+func GetDelegatorAutoRestakeKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorAutoRestakePrefix, delAddr.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -166,6 +218,11 @@ func GetValidatorAccumulatedCommissionKey(v sdk.ValAddress) []byte {
 	return append(ValidatorAccumulatedCommissionPrefix, v.Bytes()...)
 }
 
+// gets the key for a validator's missed rewards
+func GetValidatorMissedRewardsKey(v sdk.ValAddress) []byte {
+	return append(ValidatorMissedRewardsPrefix, v.Bytes()...)
+}
+
 // gets the prefix key for a validator's slash fractions
 func GetValidatorSlashEventPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorSlashEventPrefix, v.Bytes()...)
@@ -191,3 +248,10 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for a community pool stream
+func GetCommunityPoolStreamKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(CommunityPoolStreamPrefix, b...)
+}
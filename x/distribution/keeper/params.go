@@ -12,6 +12,9 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
+		ParamStoreKeyAutoRestakeInterval, int64(0),
+		ParamStoreKeyMaxAutoRestakeDelegations, uint32(0),
+		ParamStoreKeyMissedVoteRewardsRecipient, "",
 	)
 }
 
@@ -66,3 +69,42 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the number of blocks between two auto restaking rounds
+// nolint: errcheck
+func (k Keeper) GetAutoRestakeInterval(ctx sdk.Context) int64 {
+	var interval int64
+	k.paramSpace.Get(ctx, ParamStoreKeyAutoRestakeInterval, &interval)
+	return interval
+}
+
+// nolint: errcheck
+func (k Keeper) SetAutoRestakeInterval(ctx sdk.Context, interval int64) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAutoRestakeInterval, &interval)
+}
+
+// returns the maximum number of delegators restaked in a single block
+// nolint: errcheck
+func (k Keeper) GetMaxAutoRestakeDelegations(ctx sdk.Context) uint32 {
+	var max uint32
+	k.paramSpace.Get(ctx, ParamStoreKeyMaxAutoRestakeDelegations, &max)
+	return max
+}
+
+// nolint: errcheck
+func (k Keeper) SetMaxAutoRestakeDelegations(ctx sdk.Context, max uint32) {
+	k.paramSpace.Set(ctx, ParamStoreKeyMaxAutoRestakeDelegations, &max)
+}
+
+// returns the recipient of the rewards of validators that missed a vote
+// nolint: errcheck
+func (k Keeper) GetMissedVoteRewardsRecipient(ctx sdk.Context) string {
+	var recipient string
+	k.paramSpace.Get(ctx, ParamStoreKeyMissedVoteRewardsRecipient, &recipient)
+	return recipient
+}
+
+// nolint: errcheck
+func (k Keeper) SetMissedVoteRewardsRecipient(ctx sdk.Context, recipient string) {
+	k.paramSpace.Set(ctx, ParamStoreKeyMissedVoteRewardsRecipient, &recipient)
+}
@@ -42,6 +42,30 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryAutoRestake:
+			return queryDelegatorAutoRestake(ctx, path[1:], req, k)
+
+		case types.QueryAutoRestakeDelegators:
+			return queryAutoRestakeDelegators(ctx, path[1:], req, k)
+
+		case types.QueryValidatorMissedRewards:
+			return queryValidatorMissedRewards(ctx, path[1:], req, k)
+
+		case types.QueryCommunityPoolStreams:
+			return queryCommunityPoolStreams(ctx, path[1:], req, k)
+
+		case types.QueryCommunityPoolStream:
+			return queryCommunityPoolStream(ctx, path[1:], req, k)
+
+		case types.QueryNetworkAPR:
+			return queryNetworkAPR(ctx, path[1:], req, k)
+
+		case types.QueryValidatorAPR:
+			return queryValidatorAPR(ctx, path[1:], req, k)
+
+		case types.QueryEstimatedRewards:
+			return queryEstimatedRewards(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
 		}
@@ -74,6 +98,24 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamAutoRestakeInterval:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetAutoRestakeInterval(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamMaxAutoRestakeDelegations:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetMaxAutoRestakeDelegations(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamMissedVoteRewardsRecipient:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetMissedVoteRewardsRecipient(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -92,6 +134,19 @@ func queryValidatorOutstandingRewards(ctx sdk.Context, path []string, req abci.R
 	return bz, nil
 }
 
+func queryValidatorMissedRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorMissedRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetValidatorMissedRewards(ctx, params.ValidatorAddress))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func queryValidatorCommission(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryValidatorCommissionParams
 	err := k.cdc.UnmarshalJSON(req.Data, &params)
@@ -248,3 +303,123 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 	}
 	return bz, nil
 }
+
+func queryCommunityPoolStreams(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	streams := make(types.CommunityPoolStreams, 0)
+	k.IterateCommunityPoolStreams(ctx, func(stream types.CommunityPoolStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, streams)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryCommunityPoolStream(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCommunityPoolStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	stream, found := k.GetCommunityPoolStream(ctx, params.StreamID)
+	if !found {
+		return nil, types.ErrUnknownCommunityPoolStream(k.codespace, params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryNetworkAPR(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetNetworkAPR(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryValidatorAPR(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorAPRParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	val := k.stakingKeeper.Validator(ctx, params.ValidatorAddress)
+	if val == nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("validator %s does not exist", params.ValidatorAddress))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetValidatorAPR(ctx, val))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryEstimatedRewards(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEstimatedRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if params.Amount == (sdk.Int{}) || !params.Amount.IsPositive() || params.Blocks <= 0 {
+		return nil, sdk.ErrUnknownRequest("delegation amount and number of blocks must be positive")
+	}
+
+	val := k.stakingKeeper.Validator(ctx, params.ValidatorAddress)
+	if val == nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("validator %s does not exist", params.ValidatorAddress))
+	}
+
+	rewards, sdkErr := k.EstimateDelegationRewards(ctx, val, params.Amount, params.Blocks)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, rewards)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryDelegatorAutoRestake(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorAutoRestakeParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	enabled := k.GetDelegatorAutoRestake(ctx, params.DelegatorAddress)
+	res := types.NewQueryDelegatorAutoRestakeResponse(params.DelegatorAddress, enabled)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryAutoRestakeDelegators(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	delegators := make(types.AutoRestakeDelegators, 0)
+	k.IterateDelegatorAutoRestakes(ctx, func(del sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, del)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, delegators)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -71,6 +71,19 @@ func getQueriedValidatorOutstandingRewards(t *testing.T, ctx sdk.Context, cdc *c
 	return
 }
 
+func getQueriedValidatorMissedRewards(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (missedRewards sdk.DecCoins) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorMissedRewards}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryValidatorMissedRewardsParams(validatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryValidatorMissedRewards}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &missedRewards))
+
+	return
+}
+
 func getQueriedValidatorCommission(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (validatorCommission sdk.DecCoins) {
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryValidatorCommission}, "/"),
@@ -136,6 +149,58 @@ func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, qu
 	return
 }
 
+func getQueriedDelegatorAutoRestake(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (response types.QueryDelegatorAutoRestakeResponse) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAutoRestake}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorAutoRestakeParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryAutoRestake}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &response))
+
+	return
+}
+
+func getQueriedAutoRestakeDelegators(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (delegators types.AutoRestakeDelegators) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAutoRestakeDelegators}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryAutoRestakeDelegators}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &delegators))
+
+	return
+}
+
+func getQueriedCommunityPoolStreams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (streams types.CommunityPoolStreams) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommunityPoolStreams}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCommunityPoolStreams}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &streams))
+
+	return
+}
+
+func getQueriedCommunityPoolStream(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, streamID uint64) (stream types.CommunityPoolStream) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommunityPoolStream}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryCommunityPoolStreamParams(streamID)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCommunityPoolStream}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &stream))
+
+	return
+}
+
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
 	types.RegisterCodec(cdc)
@@ -164,6 +229,19 @@ func TestQueries(t *testing.T) {
 	retOutstandingRewards := getQueriedValidatorOutstandingRewards(t, ctx, cdc, querier, valOpAddr1)
 	require.Equal(t, outstandingRewards, retOutstandingRewards)
 
+	// test missed rewards query
+	missedRewards := sdk.DecCoins{{"mytoken", sdk.NewDec(5)}}
+	keeper.SetValidatorMissedRewards(ctx, valOpAddr1, missedRewards)
+	require.Equal(t, missedRewards, getQueriedValidatorMissedRewards(t, ctx, cdc, querier, valOpAddr1))
+	require.True(t, getQueriedValidatorMissedRewards(t, ctx, cdc, querier, valOpAddr2).IsZero())
+
+	// test community pool stream queries
+	require.Empty(t, getQueriedCommunityPoolStreams(t, ctx, cdc, querier))
+	stream := types.NewCommunityPoolStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 10, 20, 5)
+	keeper.SetCommunityPoolStream(ctx, stream)
+	require.Equal(t, types.CommunityPoolStreams{stream}.String(), getQueriedCommunityPoolStreams(t, ctx, cdc, querier).String())
+	require.Equal(t, stream.String(), getQueriedCommunityPoolStream(t, ctx, cdc, querier, 1).String())
+
 	// test validator commission query
 	commission := sdk.DecCoins{{"token1", sdk.NewDec(4)}, {"token2", sdk.NewDec(2)}}
 	keeper.SetValidatorAccumulatedCommission(ctx, valOpAddr1, commission)
@@ -214,4 +292,12 @@ func TestQueries(t *testing.T) {
 	// currently community pool hold nothing so we should return null
 	communityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
 	require.Nil(t, communityPool)
+
+	// test auto restake queries
+	keeper.SetDelegatorAutoRestake(ctx, delAddr1, true)
+	autoRestake := getQueriedDelegatorAutoRestake(t, ctx, cdc, querier, delAddr1)
+	require.Equal(t, types.NewQueryDelegatorAutoRestakeResponse(delAddr1, true), autoRestake)
+	autoRestake = getQueriedDelegatorAutoRestake(t, ctx, cdc, querier, delAddr2)
+	require.False(t, autoRestake.Enabled)
+	require.Equal(t, types.AutoRestakeDelegators{delAddr1}, getQueriedAutoRestakeDelegators(t, ctx, cdc, querier))
 }
@@ -41,6 +41,59 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get whether auto restaking is enabled for a delegator
+func (k Keeper) GetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetDelegatorAutoRestakeKey(delAddr))
+}
+
+// enable or disable auto restaking for a delegator
+func (k Keeper) SetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(GetDelegatorAutoRestakeKey(delAddr))
+		return
+	}
+	store.Set(GetDelegatorAutoRestakeKey(delAddr), []byte{})
+}
+
+// iterate over the delegators with auto restaking enabled
+func (k Keeper) IterateDelegatorAutoRestakes(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, DelegatorAutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(GetDelegatorAutoRestakeAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// get the last delegation restaked in the current auto restaking round, if
+// a round is in progress
+func (k Keeper) GetAutoRestakeCursor(ctx sdk.Context) (cursor types.AutoRestakeCursor, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(AutoRestakeCursorKey)
+	if b == nil {
+		return cursor, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &cursor)
+	return cursor, true
+}
+
+// set the last delegation restaked in the current auto restaking round
+func (k Keeper) SetAutoRestakeCursor(ctx sdk.Context, cursor types.AutoRestakeCursor) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(cursor)
+	store.Set(AutoRestakeCursorKey, b)
+}
+
+// delete the auto restaking cursor, ending the current round
+func (k Keeper) DeleteAutoRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(AutoRestakeCursorKey)
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -270,6 +323,45 @@ func (k Keeper) IterateValidatorAccumulatedCommissions(ctx sdk.Context, handler
 	}
 }
 
+// get the rewards missed by a validator
+func (k Keeper) GetValidatorMissedRewards(ctx sdk.Context, val sdk.ValAddress) (rewards types.ValidatorMissedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetValidatorMissedRewardsKey(val))
+	if b == nil {
+		return types.ValidatorMissedRewards{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &rewards)
+	return
+}
+
+// set the rewards missed by a validator
+func (k Keeper) SetValidatorMissedRewards(ctx sdk.Context, val sdk.ValAddress, rewards types.ValidatorMissedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(GetValidatorMissedRewardsKey(val), b)
+}
+
+// delete the rewards missed by a validator
+func (k Keeper) DeleteValidatorMissedRewards(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorMissedRewardsKey(val))
+}
+
+// iterate over the rewards missed by validators
+func (k Keeper) IterateValidatorMissedRewards(ctx sdk.Context, handler func(val sdk.ValAddress, rewards types.ValidatorMissedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ValidatorMissedRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.ValidatorMissedRewards
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &rewards)
+		addr := GetValidatorMissedRewardsAddress(iter.Key())
+		if handler(addr, rewards) {
+			break
+		}
+	}
+}
+
 // get validator outstanding rewards
 func (k Keeper) GetValidatorOutstandingRewards(ctx sdk.Context, val sdk.ValAddress) (rewards types.ValidatorOutstandingRewards) {
 	store := ctx.KVStore(k.storeKey)
@@ -377,3 +469,59 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get a community pool stream
+func (k Keeper) GetCommunityPoolStream(ctx sdk.Context, id uint64) (stream types.CommunityPoolStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetCommunityPoolStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a community pool stream
+func (k Keeper) SetCommunityPoolStream(ctx sdk.Context, stream types.CommunityPoolStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(GetCommunityPoolStreamKey(stream.ID), b)
+}
+
+// delete a community pool stream
+func (k Keeper) DeleteCommunityPoolStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetCommunityPoolStreamKey(id))
+}
+
+// iterate over community pool streams, in ID order
+func (k Keeper) IterateCommunityPoolStreams(ctx sdk.Context, handler func(stream types.CommunityPoolStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, CommunityPoolStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.CommunityPoolStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get the ID of the next community pool stream
+func (k Keeper) GetNextCommunityPoolStreamID(ctx sdk.Context) (id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(NextCommunityPoolStreamIDKey)
+	if b == nil {
+		return 0
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &id)
+	return
+}
+
+// set the ID of the next community pool stream
+func (k Keeper) SetNextCommunityPoolStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(id)
+	store.Set(NextCommunityPoolStreamIDKey, b)
+}
@@ -16,6 +16,8 @@ import (
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	"github.com/hyperspeednetwork/hsnhub/x/bank"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+	"github.com/hyperspeednetwork/hsnhub/x/mint"
 	"github.com/hyperspeednetwork/hsnhub/x/params"
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
 	"github.com/hyperspeednetwork/hsnhub/x/supply"
@@ -23,7 +25,7 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/x/distribution/types"
 )
 
-//nolint: deadcode unused
+// nolint: deadcode unused
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delPk2   = ed25519.GenPrivKey().PubKey()
@@ -99,6 +101,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyMint := sdk.NewKVStoreKey(mint.StoreKey)
 	keyParams := sdk.NewKVStoreKey(params.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
 
@@ -109,6 +112,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
 	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
@@ -133,22 +137,32 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
 	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName:     nil,
-		types.ModuleName:          nil,
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		auth.FeeCollectorName:         nil,
+		types.ModuleName:              nil,
+		mint.ModuleName:               {supply.Minter},
+		staking.NotBondedPoolName:     {supply.Burner, supply.Staking},
+		staking.BondedPoolName:        {supply.Burner, supply.Staking},
+		staking.TokenizeSharePoolName: {supply.Minter, supply.Burner},
+		govtypes.ModuleName:           {supply.Burner},
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
 
 	sk := staking.NewKeeper(cdc, keyStaking, tkeyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	sk.SetParams(ctx, staking.DefaultParams())
 
-	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), sk, supplyKeeper, types.DefaultCodespace, auth.FeeCollectorName, blacklistedAddrs)
+	mintKeeper := mint.NewKeeper(cdc, keyMint, pk.Subspace(mint.DefaultParamspace), &sk, supplyKeeper, auth.FeeCollectorName)
+
+	keeper := NewKeeper(cdc, keyDistr, pk.Subspace(DefaultParamspace), sk, supplyKeeper, mintKeeper, types.DefaultCodespace, auth.FeeCollectorName, blacklistedAddrs)
 
 	initCoins := sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), initTokens))
 	totalSupply := sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), initTokens.MulRaw(int64(len(TestAddrs)))))
 	supplyKeeper.SetSupply(ctx, supply.NewSupply(totalSupply))
 
+	// mint 13% of the total supply per year
+	mintKeeper.SetParams(ctx, mint.DefaultParams())
+	mintKeeper.SetMinter(ctx, mint.NewMinter(sdk.NewDecWithPrec(13, 2),
+		sdk.NewDecWithPrec(13, 2).MulInt(totalSupply.AmountOf(sk.BondDenom(ctx)))))
+
 	// fill all the addresses with some coins, set the loose pool tokens simultaneously
 	for _, addr := range TestAddrs {
 		_, err := bankKeeper.AddCoins(ctx, addr, initCoins)
@@ -169,6 +183,10 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	keeper.SetCommunityTax(ctx, communityTax)
 	keeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(1, 2))
 	keeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(4, 2))
+	keeper.SetAutoRestakeInterval(ctx, 0)
+	keeper.SetMaxAutoRestakeDelegations(ctx, 0)
+	keeper.SetMissedVoteRewardsRecipient(ctx, types.MissedVoteRewardsRecipientNone)
+	keeper.SetNextCommunityPoolStreamID(ctx, 1)
 
 	return ctx, accountKeeper, bankKeeper, keeper, sk, pk, supplyKeeper
 }
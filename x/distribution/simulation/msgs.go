@@ -87,6 +87,64 @@ func SimulateMsgWithdrawValidatorCommission(m auth.AccountKeeper, k distribution
 	}
 }
 
+// SimulateMsgSetAutoRestake generates a MsgSetAutoRestake with random values.
+func SimulateMsgSetAutoRestake(m auth.AccountKeeper, k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		delegatorAccount := simulation.RandomAcc(r, accs)
+		msg := distribution.NewMsgSetAutoRestake(delegatorAccount.Address, r.Intn(4) != 0)
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgFundCommunityPool generates a MsgFundCommunityPool with random values.
+func SimulateMsgFundCommunityPool(m auth.AccountKeeper, k distribution.Keeper) simulation.Operation {
+	handler := distribution.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		funder := simulation.RandomAcc(r, accs)
+		coins := m.GetAccount(ctx, funder.Address).GetCoins()
+		if coins.Empty() {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, nil
+		}
+
+		coin := coins[r.Intn(len(coins))]
+		amount := simulation.RandomAmount(r, coin.Amount)
+		if amount.IsZero() {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, nil
+		}
+
+		msg := distribution.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)), funder.Address)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(distribution.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateCommunityPoolSpendProposalContent generates random community-pool-spend proposal content
 func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsim.ContentSimulator {
 	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
@@ -109,3 +167,53 @@ func SimulateCommunityPoolSpendProposalContent(k distribution.Keeper) govsim.Con
 		)
 	}
 }
+
+// SimulateCommunityPoolStreamProposalContent generates random community-pool-stream proposal content
+func SimulateCommunityPoolStreamProposalContent(k distribution.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		recipientAcc := simulation.RandomAcc(r, accs)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+		balance := k.GetFeePool(ctx).CommunityPool
+		if len(balance) > 0 {
+			denomIndex := r.Intn(len(balance))
+			amount, goErr := simulation.RandPositiveInt(r, balance[denomIndex].Amount.TruncateInt())
+			if goErr == nil {
+				coins = sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount))
+			}
+		}
+
+		startHeight := ctx.BlockHeight() + int64(r.Intn(10))
+		endHeight := startHeight + 1 + int64(r.Intn(100))
+		return distribution.NewCommunityPoolStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			recipientAcc.Address,
+			coins,
+			startHeight,
+			endHeight,
+			1+int64(r.Intn(10)),
+		)
+	}
+}
+
+// SimulateCancelCommunityPoolStreamProposalContent generates random cancel-community-pool-stream proposal content
+func SimulateCancelCommunityPoolStreamProposalContent(k distribution.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		var ids []uint64
+		k.IterateCommunityPoolStreams(ctx, func(stream distribution.CommunityPoolStream) (stop bool) {
+			ids = append(ids, stream.ID)
+			return false
+		})
+
+		// an unknown stream makes the proposal fail once it passes
+		var streamID uint64
+		if len(ids) > 0 {
+			streamID = ids[r.Intn(len(ids))]
+		}
+		return distribution.NewCancelCommunityPoolStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			streamID,
+		)
+	}
+}
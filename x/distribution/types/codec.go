@@ -9,7 +9,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake", nil)
+	cdc.RegisterConcrete(MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(MsgCommunityPoolSpend{}, "cosmos-sdk/MsgCommunityPoolSpend", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal", nil)
+	cdc.RegisterConcrete(CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal", nil)
 }
 
 // generic sealed codec to be used throughout module
@@ -25,3 +25,24 @@ func NewDelegatorStartingInfo(previousPeriod uint64, stake sdk.Dec, height uint6
 		Height:         height,
 	}
 }
+
+// position of the current auto restaking round, the last delegation restaked.
+// An empty validator address means that every delegation of the delegator was
+// processed.
+type AutoRestakeCursor struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // last delegator restaked
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // validator of the last delegation restaked
+}
+
+// create a new AutoRestakeCursor
+func NewAutoRestakeCursor(delAddr sdk.AccAddress, valAddr sdk.ValAddress) AutoRestakeCursor {
+	return AutoRestakeCursor{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+	}
+}
+
+// Empty returns true if no auto restaking round is in progress
+func (c AutoRestakeCursor) Empty() bool {
+	return c.DelegatorAddress.Empty()
+}
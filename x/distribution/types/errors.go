@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
@@ -13,6 +15,7 @@ const (
 	CodeNoDistributionInfo      CodeType          = 104
 	CodeNoValidatorCommission   CodeType          = 105
 	CodeSetWithdrawAddrDisabled CodeType          = 106
+	CodeUnknownStream           CodeType          = 107
 )
 
 func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
@@ -45,3 +48,12 @@ func ErrInvalidProposalAmount(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyProposalRecipient(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "invalid community pool spend proposal recipient")
 }
+func ErrInvalidCommunityPoolStream(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("invalid community pool stream: %s", msg))
+}
+func ErrUnknownCommunityPoolStream(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownStream, fmt.Sprintf("community pool stream %d does not exist", id))
+}
+func ErrEstimateOverflow(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "estimated rewards overflow, use a smaller amount or number of blocks")
+}
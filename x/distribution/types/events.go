@@ -8,9 +8,19 @@ var (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
+	EventTypeMissedRewards      = "missed_rewards"
+	EventTypeFundCommunityPool  = "fund_community_pool"
+	EventTypeStreamPayment      = "community_pool_stream_payment"
+	EventTypeCommunityPoolSpend = "community_pool_spend"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/mint"
 	"github.com/hyperspeednetwork/hsnhub/x/staking"
 	stakingexported "github.com/hyperspeednetwork/hsnhub/x/staking/exported"
 	supplyexported "github.com/hyperspeednetwork/hsnhub/x/supply/exported"
@@ -38,11 +39,22 @@ type StakingKeeper interface {
 
 	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
+	IterateDelegationsAfter(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress,
+		fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []staking.Delegation
+
+	// used to restake the delegation rewards of delegators with auto restaking enabled
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	ValidateVotingPowerSoftLimit(ctx sdk.Context, validator staking.Validator, bondAmt sdk.Int,
+		tokenSrc sdk.BondStatus) sdk.Error
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
+		validator staking.Validator, subtractAccount bool) (newShares sdk.Dec, err sdk.Error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -56,6 +68,13 @@ type StakingHooks interface {
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
 }
 
+// MintKeeper defines the expected mint keeper, used to estimate the staking
+// rewards (noalias)
+type MintKeeper interface {
+	GetMinter(ctx sdk.Context) mint.Minter
+	GetParams(ctx sdk.Context) mint.Params
+}
+
 // SupplyKeeper defines the expected supply Keeper (noalias)
 type SupplyKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
@@ -53,6 +53,12 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type ValidatorMissedRewardsRecord struct {
+	ValidatorAddress sdk.ValAddress         `json:"validator_address" yaml:"validator_address"`
+	MissedRewards    ValidatorMissedRewards `json:"missed_rewards" yaml:"missed_rewards"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	FeePool                         FeePool                                `json:"fee_pool" yaml:"fee_pool"`
@@ -60,6 +66,9 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled" yaml:"withdraw_addr_enabled"`
+	AutoRestakeInterval             int64                                  `json:"auto_restake_interval" yaml:"auto_restake_interval"`
+	MaxAutoRestakeDelegations       uint32                                 `json:"max_auto_restake_delegations" yaml:"max_auto_restake_delegations"`
+	MissedVoteRewardsRecipient      string                                 `json:"missed_vote_rewards_recipient" yaml:"missed_vote_rewards_recipient"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos" yaml:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards" yaml:"outstanding_rewards"`
@@ -68,13 +77,21 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	AutoRestakeDelegators           []sdk.AccAddress                       `json:"auto_restake_delegators" yaml:"auto_restake_delegators"`
+	AutoRestakeCursor               AutoRestakeCursor                      `json:"auto_restake_cursor" yaml:"auto_restake_cursor"`
+	ValidatorMissedRewards          []ValidatorMissedRewardsRecord         `json:"validator_missed_rewards" yaml:"validator_missed_rewards"`
+	CommunityPoolStreams            []CommunityPoolStream                  `json:"community_pool_streams" yaml:"community_pool_streams"`
+	NextCommunityPoolStreamID       uint64                                 `json:"next_community_pool_stream_id" yaml:"next_community_pool_stream_id"`
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
+	withdrawAddrEnabled bool, autoRestakeInterval int64, maxAutoRestakeDelegations uint32,
+	missedVoteRewardsRecipient string,
+	dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
-	slashes []ValidatorSlashEventRecord) GenesisState {
+	slashes []ValidatorSlashEventRecord, autoRestakes []sdk.AccAddress, autoRestakeCursor AutoRestakeCursor,
+	missed []ValidatorMissedRewardsRecord, streams []CommunityPoolStream, nextStreamID uint64) GenesisState {
 
 	return GenesisState{
 		FeePool:                         feePool,
@@ -82,6 +99,9 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		AutoRestakeInterval:             autoRestakeInterval,
+		MaxAutoRestakeDelegations:       maxAutoRestakeDelegations,
+		MissedVoteRewardsRecipient:      missedVoteRewardsRecipient,
 		DelegatorWithdrawInfos:          dwis,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
@@ -90,6 +110,11 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakeDelegators:           autoRestakes,
+		AutoRestakeCursor:               autoRestakeCursor,
+		ValidatorMissedRewards:          missed,
+		CommunityPoolStreams:            streams,
+		NextCommunityPoolStreamID:       nextStreamID,
 	}
 }
 
@@ -101,6 +126,9 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		AutoRestakeInterval:             100,
+		MaxAutoRestakeDelegations:       100,
+		MissedVoteRewardsRecipient:      MissedVoteRewardsRecipientNone,
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
@@ -109,6 +137,11 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakeDelegators:           []sdk.AccAddress{},
+		AutoRestakeCursor:               AutoRestakeCursor{},
+		ValidatorMissedRewards:          []ValidatorMissedRewardsRecord{},
+		CommunityPoolStreams:            []CommunityPoolStream{},
+		NextCommunityPoolStreamID:       1,
 	}
 }
 
@@ -132,5 +165,30 @@ func ValidateGenesis(data GenesisState) error {
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if data.AutoRestakeInterval < 0 {
+		return fmt.Errorf("distribution parameter AutoRestakeInterval should be non-negative, is %d",
+			data.AutoRestakeInterval)
+	}
+	if err := ValidateMissedVoteRewardsRecipient(data.MissedVoteRewardsRecipient); err != nil {
+		return err
+	}
+	if data.NextCommunityPoolStreamID == 0 {
+		return fmt.Errorf("next community pool stream ID should be positive")
+	}
+	seenStreams := make(map[uint64]bool)
+	for _, stream := range data.CommunityPoolStreams {
+		if stream.ID >= data.NextCommunityPoolStreamID {
+			return fmt.Errorf("community pool stream ID %d should be lower than the next stream ID %d",
+				stream.ID, data.NextCommunityPoolStreamID)
+		}
+		if seenStreams[stream.ID] {
+			return fmt.Errorf("community pool stream ID %d is used twice", stream.ID)
+		}
+		seenStreams[stream.ID] = true
+
+		if err := stream.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid community pool stream %d: %s", stream.ID, err.Error())
+		}
+	}
 	return data.FeePool.ValidateGenesis()
 }
@@ -6,7 +6,8 @@ import (
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{},
+	&MsgSetAutoRestake{}, &MsgFundCommunityPool{}, &MsgCommunityPoolSpend{}
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -116,3 +117,120 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// msg struct for enabling or disabling the automatic restaking of a delegator's rewards
+type MsgSetAutoRestake struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, enabled bool) MsgSetAutoRestake {
+	return MsgSetAutoRestake{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetAutoRestake) Type() string  { return "set_auto_restake" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// msg struct for depositing coins into the community pool
+type MsgFundCommunityPool struct {
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+}
+
+func NewMsgFundCommunityPool(amount sdk.Coins, depositor sdk.AccAddress) MsgFundCommunityPool {
+	return MsgFundCommunityPool{
+		Amount:    amount,
+		Depositor: depositor,
+	}
+}
+
+func (msg MsgFundCommunityPool) Route() string { return ModuleName }
+func (msg MsgFundCommunityPool) Type() string  { return "fund_community_pool" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgFundCommunityPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgFundCommunityPool) ValidateBasic() sdk.Error {
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if msg.Depositor.Empty() {
+		return sdk.ErrInvalidAddress(msg.Depositor.String())
+	}
+	return nil
+}
+
+// msg struct for spending coins of the community pool, which can only be
+// executed by the governance module account through an exec proposal
+type MsgCommunityPoolSpend struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+func NewMsgCommunityPoolSpend(authority, recipient sdk.AccAddress, amount sdk.Coins) MsgCommunityPoolSpend {
+	return MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (msg MsgCommunityPoolSpend) Route() string { return ModuleName }
+func (msg MsgCommunityPoolSpend) Type() string  { return "community_pool_spend" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgCommunityPoolSpend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCommunityPoolSpend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgCommunityPoolSpend) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return sdk.ErrInvalidAddress(msg.Authority.String())
+	}
+	if msg.Recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	return nil
+}
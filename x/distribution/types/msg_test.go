@@ -72,3 +72,70 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoRestake
+func TestMsgSetAutoRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestake(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgFundCommunityPool
+func TestMsgFundCommunityPool(t *testing.T) {
+	tests := []struct {
+		amount     sdk.Coins
+		depositor  sdk.AccAddress
+		expectPass bool
+	}{
+		{sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), delAddr1, true},
+		{sdk.Coins{}, delAddr1, false},
+		{sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, delAddr1, false},
+		{sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgFundCommunityPool(tc.amount, tc.depositor)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCommunityPoolSpend
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  sdk.AccAddress
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{delAddr1, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), true},
+		{emptyDelAddr, delAddr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), false},
+		{delAddr1, emptyDelAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), false},
+		{delAddr1, delAddr2, sdk.Coins{}, false},
+		{delAddr1, delAddr2, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
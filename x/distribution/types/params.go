@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Recipients of the rewards of validators that missed the vote of a block
+const (
+	// the rewards are allocated to the validator regardless of its vote
+	MissedVoteRewardsRecipientNone = "none"
+	// the rewards are added to the community pool
+	MissedVoteRewardsRecipientCommunityPool = "community_pool"
+	// the rewards are allocated to the validators that signed the block
+	MissedVoteRewardsRecipientSigners = "signers"
+)
+
+// ValidateMissedVoteRewardsRecipient validates the MissedVoteRewardsRecipient parameter
+func ValidateMissedVoteRewardsRecipient(recipient string) error {
+	switch recipient {
+	case MissedVoteRewardsRecipientNone, MissedVoteRewardsRecipientCommunityPool, MissedVoteRewardsRecipientSigners:
+		return nil
+	default:
+		return fmt.Errorf("distribution parameter MissedVoteRewardsRecipient should be one of %s, %s or %s, is %s",
+			MissedVoteRewardsRecipientNone, MissedVoteRewardsRecipientCommunityPool, MissedVoteRewardsRecipientSigners,
+			recipient)
+	}
+}
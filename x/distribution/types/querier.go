@@ -13,11 +13,24 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryAutoRestake                 = "auto_restake"
+	QueryAutoRestakeDelegators       = "auto_restake_delegators"
+	QueryValidatorMissedRewards      = "validator_missed_rewards"
+	QueryCommunityPoolStreams        = "community_pool_streams"
+	QueryCommunityPoolStream         = "community_pool_stream"
+	QueryNetworkAPR                  = "network_apr"
+	QueryValidatorAPR                = "validator_apr"
+	QueryEstimatedRewards            = "estimated_rewards"
 
 	ParamCommunityTax        = "community_tax"
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+
+	ParamAutoRestakeInterval       = "auto_restake_interval"
+	ParamMaxAutoRestakeDelegations = "max_auto_restake_delegations"
+
+	ParamMissedVoteRewardsRecipient = "missed_vote_rewards_recipient"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -32,6 +45,46 @@ func NewQueryValidatorOutstandingRewardsParams(validatorAddr sdk.ValAddress) Que
 	}
 }
 
+// params for query 'custom/distr/validator_missed_rewards'
+type QueryValidatorMissedRewardsParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// creates a new instance of QueryValidatorMissedRewardsParams
+func NewQueryValidatorMissedRewardsParams(validatorAddr sdk.ValAddress) QueryValidatorMissedRewardsParams {
+	return QueryValidatorMissedRewardsParams{
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// params for query 'custom/distr/validator_apr'
+type QueryValidatorAPRParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// creates a new instance of QueryValidatorAPRParams
+func NewQueryValidatorAPRParams(validatorAddr sdk.ValAddress) QueryValidatorAPRParams {
+	return QueryValidatorAPRParams{
+		ValidatorAddress: validatorAddr,
+	}
+}
+
+// params for query 'custom/distr/estimated_rewards'
+type QueryEstimatedRewardsParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Int        `json:"amount" yaml:"amount"`
+	Blocks           int64          `json:"blocks" yaml:"blocks"`
+}
+
+// creates a new instance of QueryEstimatedRewardsParams
+func NewQueryEstimatedRewardsParams(validatorAddr sdk.ValAddress, amount sdk.Int, blocks int64) QueryEstimatedRewardsParams {
+	return QueryEstimatedRewardsParams{
+		ValidatorAddress: validatorAddr,
+		Amount:           amount,
+		Blocks:           blocks,
+	}
+}
+
 // params for query 'custom/distr/validator_commission'
 type QueryValidatorCommissionParams struct {
 	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
@@ -95,3 +148,23 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/auto_restake'
+type QueryDelegatorAutoRestakeParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+}
+
+// NewQueryDelegatorAutoRestakeParams creates a new instance of QueryDelegatorAutoRestakeParams.
+func NewQueryDelegatorAutoRestakeParams(delegatorAddr sdk.AccAddress) QueryDelegatorAutoRestakeParams {
+	return QueryDelegatorAutoRestakeParams{DelegatorAddress: delegatorAddr}
+}
+
+// params for query 'custom/distr/community_pool_stream'
+type QueryCommunityPoolStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQueryCommunityPoolStreamParams creates a new instance of QueryCommunityPoolStreamParams.
+func NewQueryCommunityPoolStreamParams(streamID uint64) QueryCommunityPoolStreamParams {
+	return QueryCommunityPoolStreamParams{StreamID: streamID}
+}
@@ -44,3 +44,86 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
+
+// QueryDelegatorAutoRestakeResponse defines the properties of
+// QueryAutoRestake query's response.
+type QueryDelegatorAutoRestakeResponse struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+// NewQueryDelegatorAutoRestakeResponse constructs a QueryDelegatorAutoRestakeResponse
+func NewQueryDelegatorAutoRestakeResponse(delAddr sdk.AccAddress, enabled bool) QueryDelegatorAutoRestakeResponse {
+	return QueryDelegatorAutoRestakeResponse{DelegatorAddress: delAddr, Enabled: enabled}
+}
+
+func (res QueryDelegatorAutoRestakeResponse) String() string {
+	return fmt.Sprintf(`Delegator Auto Restake:
+  DelegatorAddress: %s
+  Enabled:          %t`, res.DelegatorAddress, res.Enabled)
+}
+
+// AutoRestakeDelegators defines the delegators with auto restaking enabled,
+// the response of the QueryAutoRestakeDelegators query.
+type AutoRestakeDelegators []sdk.AccAddress
+
+func (ds AutoRestakeDelegators) String() string {
+	out := "Auto Restake Delegators:"
+	for _, del := range ds {
+		out += fmt.Sprintf("\n  %s", del)
+	}
+	return out
+}
+
+// QueryNetworkAPRResponse defines the properties of QueryNetworkAPR query's
+// response. The nominal APR is split into the rewards of the validators for
+// their vote and the proposer rewards, before validator commission.
+type QueryNetworkAPRResponse struct {
+	AnnualProvisions sdk.Dec `json:"annual_provisions" yaml:"annual_provisions"`
+	BondedTokens     sdk.Int `json:"bonded_tokens" yaml:"bonded_tokens"`
+	VoteAPR          sdk.Dec `json:"vote_apr" yaml:"vote_apr"`
+	ProposerAPR      sdk.Dec `json:"proposer_apr" yaml:"proposer_apr"`
+	APR              sdk.Dec `json:"apr" yaml:"apr"`
+}
+
+// NewQueryNetworkAPRResponse constructs a QueryNetworkAPRResponse
+func NewQueryNetworkAPRResponse(annualProvisions sdk.Dec, bondedTokens sdk.Int,
+	voteAPR, proposerAPR sdk.Dec) QueryNetworkAPRResponse {
+	return QueryNetworkAPRResponse{
+		AnnualProvisions: annualProvisions,
+		BondedTokens:     bondedTokens,
+		VoteAPR:          voteAPR,
+		ProposerAPR:      proposerAPR,
+		APR:              voteAPR.Add(proposerAPR),
+	}
+}
+
+func (res QueryNetworkAPRResponse) String() string {
+	return fmt.Sprintf(`Network APR:
+  AnnualProvisions: %s
+  BondedTokens:     %s
+  VoteAPR:          %s
+  ProposerAPR:      %s
+  APR:              %s`, res.AnnualProvisions, res.BondedTokens, res.VoteAPR, res.ProposerAPR, res.APR)
+}
+
+// QueryValidatorAPRResponse defines the properties of QueryValidatorAPR
+// query's response, the nominal APR of the delegators of a validator after
+// commission.
+type QueryValidatorAPRResponse struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	CommissionRate   sdk.Dec        `json:"commission_rate" yaml:"commission_rate"`
+	APR              sdk.Dec        `json:"apr" yaml:"apr"`
+}
+
+// NewQueryValidatorAPRResponse constructs a QueryValidatorAPRResponse
+func NewQueryValidatorAPRResponse(valAddr sdk.ValAddress, commissionRate, apr sdk.Dec) QueryValidatorAPRResponse {
+	return QueryValidatorAPRResponse{ValidatorAddress: valAddr, CommissionRate: commissionRate, APR: apr}
+}
+
+func (res QueryValidatorAPRResponse) String() string {
+	return fmt.Sprintf(`Validator APR:
+  ValidatorAddress: %s
+  CommissionRate:   %s
+  APR:              %s`, res.ValidatorAddress, res.CommissionRate, res.APR)
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// CommunityPoolStream pays an amount from the community pool to a recipient
+// linearly between two block heights, every Interval blocks
+type CommunityPoolStream struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Paid        sdk.Coins      `json:"paid" yaml:"paid"`
+	StartHeight int64          `json:"start_height" yaml:"start_height"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+	Interval    int64          `json:"interval" yaml:"interval"`
+}
+
+// NewCommunityPoolStream creates a new community pool stream with nothing paid
+func NewCommunityPoolStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight, interval int64) CommunityPoolStream {
+
+	return CommunityPoolStream{
+		ID:          id,
+		Recipient:   recipient,
+		Amount:      amount,
+		Paid:        sdk.NewCoins(),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Interval:    interval,
+	}
+}
+
+// ValidateBasic validates the stream, e.g. a stream of the genesis state
+func (s CommunityPoolStream) ValidateBasic() sdk.Error {
+	if err := validateCommunityPoolStream(s.Recipient, s.Amount, s.StartHeight, s.EndHeight, s.Interval); err != nil {
+		return err
+	}
+	if !s.Paid.IsValid() || !s.Amount.IsAllGTE(s.Paid) {
+		return ErrInvalidCommunityPoolStream(DefaultCodespace,
+			fmt.Sprintf("paid amount %s must not exceed the amount %s", s.Paid, s.Amount))
+	}
+	return nil
+}
+
+// validateCommunityPoolStream validates the terms of a community pool stream
+func validateCommunityPoolStream(recipient sdk.AccAddress, amount sdk.Coins,
+	startHeight, endHeight, interval int64) sdk.Error {
+
+	if !amount.IsValid() || amount.Empty() {
+		return ErrInvalidProposalAmount(DefaultCodespace)
+	}
+	if recipient.Empty() {
+		return ErrEmptyProposalRecipient(DefaultCodespace)
+	}
+	if startHeight < 0 || endHeight <= startHeight {
+		return ErrInvalidCommunityPoolStream(DefaultCodespace,
+			fmt.Sprintf("end height %d must be after start height %d", endHeight, startHeight))
+	}
+	if interval <= 0 {
+		return ErrInvalidCommunityPoolStream(DefaultCodespace,
+			fmt.Sprintf("interval must be positive, is %d", interval))
+	}
+	return nil
+}
+
+// IsPaymentHeight returns whether a payment of the stream is due at the given
+// height. Payments are made every Interval blocks after the start height and
+// at every block from the end height until the stream is fully paid.
+func (s CommunityPoolStream) IsPaymentHeight(height int64) bool {
+	if height <= s.StartHeight {
+		return false
+	}
+	return height >= s.EndHeight || (height-s.StartHeight)%s.Interval == 0
+}
+
+// Streamed returns the part of the amount streamed at the given height
+func (s CommunityPoolStream) Streamed(height int64) sdk.Coins {
+	switch {
+	case height <= s.StartHeight:
+		return sdk.NewCoins()
+	case height >= s.EndHeight:
+		return s.Amount
+	}
+
+	elapsed := sdk.NewInt(height - s.StartHeight)
+	duration := sdk.NewInt(s.EndHeight - s.StartHeight)
+	streamed := make(sdk.Coins, 0, len(s.Amount))
+	for _, coin := range s.Amount {
+		streamed = append(streamed, sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return sdk.NewCoins(streamed...)
+}
+
+// Due returns the amount streamed at the given height and not yet paid
+func (s CommunityPoolStream) Due(height int64) sdk.Coins {
+	due, negative := s.Streamed(height).SafeSub(s.Paid)
+	if negative {
+		return sdk.NewCoins()
+	}
+	return due
+}
+
+// IsCompleted returns whether the whole amount of the stream was paid
+func (s CommunityPoolStream) IsCompleted() bool {
+	return s.Paid.IsAllGTE(s.Amount)
+}
+
+// String implements the Stringer interface.
+func (s CommunityPoolStream) String() string {
+	return fmt.Sprintf(`Community Pool Stream %d:
+  Recipient:    %s
+  Amount:       %s
+  Paid:         %s
+  Start Height: %d
+  End Height:   %d
+  Interval:     %d`, s.ID, s.Recipient, s.Amount, s.Paid, s.StartHeight, s.EndHeight, s.Interval)
+}
+
+// CommunityPoolStreams is a collection of CommunityPoolStream
+type CommunityPoolStreams []CommunityPoolStream
+
+func (s CommunityPoolStreams) String() string {
+	var b strings.Builder
+	for _, stream := range s {
+		b.WriteString(stream.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
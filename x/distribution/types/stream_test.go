@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestCommunityPoolStreamDue(t *testing.T) {
+	stream := NewCommunityPoolStream(1, sdk.AccAddress([]byte("recipient")),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 10, 20, 3)
+
+	tests := []struct {
+		height    int64
+		isPayment bool
+		due       int64
+	}{
+		{5, false, 0},
+		{10, false, 0},
+		{12, false, 20},
+		{13, true, 30},
+		{19, true, 90},
+		{20, true, 100},
+		{25, true, 100},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.isPayment, stream.IsPaymentHeight(tc.height), "height %d", tc.height)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", tc.due)).String(), stream.Due(tc.height).String(), "height %d", tc.height)
+	}
+
+	stream.Paid = sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), stream.Due(19))
+	require.True(t, stream.Due(12).IsZero())
+	require.False(t, stream.IsCompleted())
+
+	stream.Paid = stream.Amount
+	require.True(t, stream.IsCompleted())
+}
+
+func TestValidateGenesisCommunityPoolStreams(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	tests := []struct {
+		name       string
+		stream     CommunityPoolStream
+		nextID     uint64
+		expectPass bool
+	}{
+		{"valid", NewCommunityPoolStream(1, recipient, amount, 10, 20, 3), 2, true},
+		{"no next stream ID", NewCommunityPoolStream(1, recipient, amount, 10, 20, 3), 0, false},
+		{"ID not below the next one", NewCommunityPoolStream(2, recipient, amount, 10, 20, 3), 2, false},
+		{"zero interval", NewCommunityPoolStream(1, recipient, amount, 10, 20, 0), 2, false},
+		{"end before start", NewCommunityPoolStream(1, recipient, amount, 20, 20, 3), 2, false},
+		{"empty amount", NewCommunityPoolStream(1, recipient, sdk.NewCoins(), 10, 20, 3), 2, false},
+		{"empty recipient", NewCommunityPoolStream(1, nil, amount, 10, 20, 3), 2, false},
+	}
+	for _, tc := range tests {
+		state := DefaultGenesisState()
+		state.CommunityPoolStreams = []CommunityPoolStream{tc.stream}
+		state.NextCommunityPoolStreamID = tc.nextID
+		if tc.expectPass {
+			require.NoError(t, ValidateGenesis(state), tc.name)
+		} else {
+			require.Error(t, ValidateGenesis(state), tc.name)
+		}
+	}
+
+	// a stream cannot have paid more than its amount
+	state := DefaultGenesisState()
+	stream := NewCommunityPoolStream(1, recipient, amount, 10, 20, 3)
+	stream.Paid = amount.Add(amount)
+	state.CommunityPoolStreams = []CommunityPoolStream{stream}
+	state.NextCommunityPoolStreamID = 2
+	require.Error(t, ValidateGenesis(state))
+}
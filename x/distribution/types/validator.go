@@ -95,3 +95,7 @@ func (vs ValidatorSlashEvents) String() string {
 // outstanding (un-withdrawn) rewards for a validator
 // inexpensive to track, allows simple sanity checks
 type ValidatorOutstandingRewards = sdk.DecCoins
+
+// missed rewards for a validator
+// total rewards not allocated to the validator because it did not sign blocks
+type ValidatorMissedRewards = sdk.DecCoins
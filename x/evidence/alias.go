@@ -0,0 +1,68 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/evidence/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types
+package evidence
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	RouterKey                   = types.RouterKey
+	QuerierRoute                = types.QuerierRoute
+	DefaultCodespace            = types.DefaultCodespace
+	CodeNoEvidenceHandlerExists = types.CodeNoEvidenceHandlerExists
+	CodeInvalidEvidence         = types.CodeInvalidEvidence
+	CodeNoEvidenceExists        = types.CodeNoEvidenceExists
+	CodeEvidenceExists          = types.CodeEvidenceExists
+	EventTypeSubmitEvidence     = types.EventTypeSubmitEvidence
+	AttributeValueCategory      = types.AttributeValueCategory
+	AttributeKeyEvidenceHash    = types.AttributeKeyEvidenceHash
+	RouteEquivocation           = types.RouteEquivocation
+	TypeEquivocation            = types.TypeEquivocation
+	TypeMsgSubmitEvidence       = types.TypeMsgSubmitEvidence
+	QueryEvidence               = types.QueryEvidence
+	QueryAllEvidence            = types.QueryAllEvidence
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterCodec                = types.RegisterCodec
+	RegisterEvidenceTypeCodec    = types.RegisterEvidenceTypeCodec
+	ErrNoEvidenceHandlerExists   = types.ErrNoEvidenceHandlerExists
+	ErrInvalidEvidence           = types.ErrInvalidEvidence
+	ErrNoEvidenceExists          = types.ErrNoEvidenceExists
+	ErrEvidenceExists            = types.ErrEvidenceExists
+	ConvertDuplicateVoteEvidence = types.ConvertDuplicateVoteEvidence
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	GetEvidenceKey               = types.GetEvidenceKey
+	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
+	NewQueryEvidenceParams       = types.NewQueryEvidenceParams
+	NewQueryAllEvidenceParams    = types.NewQueryAllEvidenceParams
+	NewRouter                    = types.NewRouter
+
+	// variable aliases
+	ModuleCdc         = types.ModuleCdc
+	KeyPrefixEvidence = types.KeyPrefixEvidence
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	GenesisState           = types.GenesisState
+	MsgSubmitEvidence      = types.MsgSubmitEvidence
+	Handler                = types.Handler
+	Router                 = types.Router
+	Equivocation           = types.Equivocation
+	EvidenceList           = types.EvidenceList
+	QueryEvidenceParams    = types.QueryEvidenceParams
+	QueryAllEvidenceParams = types.QueryAllEvidenceParams
+)
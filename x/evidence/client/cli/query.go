@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// GetQueryCmd returns the cli query commands for the evidence module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	evidenceQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the evidence module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evidenceQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryEvidence(cdc),
+			GetCmdQueryAllEvidence(cdc),
+		)...,
+	)
+
+	return evidenceQueryCmd
+}
+
+// GetCmdQueryEvidence implements a command to query submitted evidence by hash.
+func GetCmdQueryEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [hash]",
+		Short: "Query for submitted evidence by hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for specific submitted evidence by its hex-encoded hash:
+
+Example:
+$ %s query %s show DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			decodedHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid evidence hash: %s", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEvidenceParams(decodedHash))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence exported.Evidence
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+}
+
+// GetCmdQueryAllEvidence implements a command to query all (paginated)
+// submitted evidence.
+func GetCmdQueryAllEvidence(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Query for all (paginated) submitted evidence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all submitted evidence:
+
+Example:
+$ %s query %s list --page=2 --limit=50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryAllEvidenceParams(viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllEvidence)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var evidence types.EvidenceList
+			if err := cdc.UnmarshalJSON(res, &evidence); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(evidence)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, rest.DefaultPage, "pagination page of evidence to query for")
+	cmd.Flags().Int(flagLimit, rest.DefaultLimit, "pagination limit of evidence to query for")
+
+	return cmd
+}
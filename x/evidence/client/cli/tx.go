@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+// GetTxCmd returns a CLI command that has all the native evidence module tx
+// commands mounted. In addition, it mounts all childCmds, implemented by outside
+// modules, under a sub-command. This allows external modules to implement custom
+// Evidence types and Handlers while having the ability to create and sign txs
+// containing them all from a single root command.
+func GetTxCmd(cdc *codec.Codec, childCmds []*cobra.Command) *cobra.Command {
+	evidenceTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Evidence transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	submitEvidenceCmd := SubmitEvidenceCmd()
+	for _, childCmd := range childCmds {
+		submitEvidenceCmd.AddCommand(client.PostCommands(childCmd)[0])
+	}
+
+	evidenceTxCmd.AddCommand(submitEvidenceCmd)
+
+	return evidenceTxCmd
+}
+
+// SubmitEvidenceCmd returns the top-level evidence submission command handler.
+// All concrete evidence submission child command handlers should be registered
+// under this command.
+func SubmitEvidenceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:                        "submit",
+		Short:                      "Submit arbitrary evidence of misbehavior",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+}
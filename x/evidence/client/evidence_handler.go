@@ -0,0 +1,32 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/client/rest"
+)
+
+type (
+	// RESTHandlerFn defines a REST service handler for evidence submission
+	RESTHandlerFn func(context.CLIContext) rest.EvidenceRESTHandler
+
+	// CLIHandlerFn defines a CLI command handler for evidence submission
+	CLIHandlerFn func(*codec.Codec) *cobra.Command
+
+	// EvidenceHandler defines a type that exposes REST and CLI client handlers for
+	// evidence submission.
+	EvidenceHandler struct {
+		CLIHandler  CLIHandlerFn
+		RESTHandler RESTHandlerFn
+	}
+)
+
+// NewEvidenceHandler creates a new EvidenceHandler object
+func NewEvidenceHandler(cliHandler CLIHandlerFn, restHandler RESTHandlerFn) EvidenceHandler {
+	return EvidenceHandler{
+		CLIHandler:  cliHandler,
+		RESTHandler: restHandler,
+	}
+}
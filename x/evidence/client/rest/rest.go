@@ -0,0 +1,28 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+)
+
+// REST query and parameter values
+const (
+	RestParamEvidenceHash = "evidence-hash"
+)
+
+// EvidenceRESTHandler defines a REST service evidence handler implemented in
+// another module. The sub-route is mounted on the evidence REST handler.
+type EvidenceRESTHandler struct {
+	SubRoute string
+	Handler  func(http.ResponseWriter, *http.Request)
+}
+
+// RegisterRoutes registers all Evidence submission handlers for the evidence module's
+// REST service handler.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, handlers []EvidenceRESTHandler) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r, handlers)
+}
@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, handlers []EvidenceRESTHandler) {
+	// Evidence submission handlers implemented in other modules are mounted
+	// under /evidence/{sub-route}.
+	evidenceRtr := r.PathPrefix("/evidence").Subrouter()
+	for _, eh := range handlers {
+		evidenceRtr.HandleFunc("/"+eh.SubRoute, eh.Handler).Methods("POST")
+	}
+}
@@ -0,0 +1,22 @@
+/*
+Package evidence implements a generic mechanism for submitting and handling
+evidence of misbehavior.
+
+Evidence is any type implementing the exported.Evidence interface. Modules that
+define their own evidence types register them on the module's codec with
+RegisterEvidenceTypeCodec and register a Handler for the evidence route on an
+evidence Router, which is then set on the Keeper with SetRouter. The router is
+sealed when set, so all handlers must be registered during app construction.
+
+Evidence is submitted with a MsgSubmitEvidence. The Keeper rejects evidence it
+has already seen (by hash) and evidence without a registered Handler; otherwise
+the Handler is executed and, if it succeeds, the evidence is persisted so that
+it may later be queried by its hash.
+
+Evidence of equivocation (double signing) reported by Tendermint in
+RequestBeginBlock is handled natively by the module's BeginBlocker. It is
+converted into an Equivocation, passed on to the slashing module to slash, jail
+and tombstone the offending validator, and persisted. Equivocation is not
+routed and therefore cannot be submitted through a MsgSubmitEvidence.
+*/
+package evidence
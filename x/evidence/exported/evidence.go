@@ -0,0 +1,42 @@
+package exported
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Evidence defines the contract which concrete evidence types of misbehavior
+// must implement.
+type Evidence interface {
+	Route() string
+	Type() string
+	String() string
+	Hash() cmn.HexBytes
+	ValidateBasic() sdk.Error
+
+	// The consensus height at which the infraction occurred
+	GetHeight() int64
+}
+
+// ValidatorEvidence extends Evidence interface to define contract
+// for evidence against malicious validators
+type ValidatorEvidence interface {
+	Evidence
+
+	// The consensus address of the malicious validator at time of infraction
+	GetConsensusAddress() sdk.ConsAddress
+
+	// The total power of the malicious validator at time of infraction
+	GetValidatorPower() int64
+}
+
+// MsgSubmitEvidence defines the specific interface a concrete message must
+// implement in order to process submitted evidence. The concrete MsgSubmitEvidence
+// must be defined at the application-level.
+type MsgSubmitEvidence interface {
+	sdk.Msg
+
+	GetEvidence() Evidence
+	GetSubmitter() sdk.AccAddress
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+// Keeper defines the evidence module's keeper. The keeper is responsible for
+// managing persistence, state transitions and query handling for the evidence
+// module.
+type Keeper struct {
+	cdc            *codec.Codec
+	storeKey       sdk.StoreKey
+	router         types.Router
+	slashingKeeper types.SlashingKeeper
+	codespace      sdk.CodespaceType
+}
+
+// NewKeeper creates a new evidence Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, slashingKeeper types.SlashingKeeper, codespace sdk.CodespaceType,
+) Keeper {
+
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		slashingKeeper: slashingKeeper,
+		codespace:      codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the evidence module's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// SetRouter sets the Evidence Handler router for the x/evidence module. Note,
+// we allow the ability to set the router after the Keeper is constructed as a
+// given Handler may need access the Keeper before being constructed. The router
+// may only be set once and will be sealed if it's not already sealed.
+func (k *Keeper) SetRouter(rtr types.Router) {
+	// It is vital to seal the Evidence Handler router as to not allow further
+	// handlers to be registered after the keeper is created since this
+	// could create invalid or non-deterministic behavior.
+	if !rtr.Sealed() {
+		rtr.Seal()
+	}
+
+	k.router = rtr
+}
+
+// GetEvidenceHandler returns a registered Handler for a given Evidence type. If
+// no handler exists, an error is returned.
+func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, sdk.Error) {
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
+		return nil, types.ErrNoEvidenceHandlerExists(k.codespace, evidenceRoute)
+	}
+
+	return k.router.GetRoute(evidenceRoute), nil
+}
+
+// SubmitEvidence attempts to match evidence against the keepers router and execute
+// the corresponding registered Evidence Handler. An error is returned if no
+// registered Handler exists or if the Handler fails. Otherwise, the evidence is
+// persisted.
+func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) sdk.Error {
+	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
+		return types.ErrEvidenceExists(k.codespace, evidence.Hash())
+	}
+
+	handler, err := k.GetEvidenceHandler(evidence.Route())
+	if err != nil {
+		return err
+	}
+
+	if err := handler(ctx, evidence); err != nil {
+		return types.ErrInvalidEvidence(k.codespace, err.Result().Log)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitEvidence,
+			sdk.NewAttribute(types.AttributeKeyEvidenceHash, evidence.Hash().String()),
+		),
+	)
+
+	k.SetEvidence(ctx, evidence)
+	return nil
+}
+
+// HandleDoubleSign implements an equivocation evidence handler. Assuming the
+// evidence is valid, the validator committing the misbehavior is slashed,
+// jailed and tombstoned by the slashing module. The evidence is then persisted
+// so that it may be queried.
+//
+// NOTE: Evidence of double signing is reported by Tendermint and is therefore
+// not subject to the evidence router.
+func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
+	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
+		k.Logger(ctx).Info(fmt.Sprintf("ignored already processed evidence %s", evidence.Hash()))
+		return
+	}
+
+	k.slashingKeeper.HandleDoubleSign(
+		ctx, crypto.Address(evidence.GetConsensusAddress()), evidence.GetHeight(),
+		evidence.GetTime(), evidence.GetValidatorPower(),
+	)
+
+	k.SetEvidence(ctx, evidence)
+}
+
+// SetEvidence sets Evidence by hash in the module's KVStore.
+func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(evidence)
+	store.Set(types.GetEvidenceKey(evidence.Hash()), bz)
+}
+
+// GetEvidence retrieves Evidence by hash if it exists. If no Evidence exists for
+// the given hash, (nil, false) is returned.
+func (k Keeper) GetEvidence(ctx sdk.Context, hash cmn.HexBytes) (evidence exported.Evidence, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetEvidenceKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &evidence)
+	return evidence, true
+}
+
+// IterateEvidence provides an interator over all stored Evidence objects. For
+// each Evidence object, cb will be called. If the cb returns true, the iterator
+// will close and stop.
+func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(exported.Evidence) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEvidence)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var evidence exported.Evidence
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &evidence)
+
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
+// GetAllEvidence returns all stored Evidence objects.
+func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []exported.Evidence) {
+	k.IterateEvidence(ctx, func(e exported.Evidence) bool {
+		evidence = append(evidence, e)
+		return false
+	})
+	return evidence
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+// testEvidence is a custom Evidence type used to exercise the evidence router
+type testEvidence struct {
+	Height    int64  `json:"height"`
+	Submitted string `json:"submitted"`
+}
+
+func (e testEvidence) Route() string      { return "test" }
+func (e testEvidence) Type() string       { return "test" }
+func (e testEvidence) String() string     { return fmt.Sprintf("%d:%s", e.Height, e.Submitted) }
+func (e testEvidence) Hash() cmn.HexBytes { return tmhash.Sum([]byte(e.String())) }
+func (e testEvidence) GetHeight() int64   { return e.Height }
+
+func (e testEvidence) ValidateBasic() sdk.Error {
+	if e.Height < 1 {
+		return types.ErrInvalidEvidence(types.DefaultCodespace, "invalid height")
+	}
+	return nil
+}
+
+// mockSlashingKeeper records the double sign infractions it is asked to handle
+type mockSlashingKeeper struct {
+	doubleSigns []crypto.Address
+}
+
+func (sk *mockSlashingKeeper) HandleDoubleSign(_ sdk.Context, addr crypto.Address, _ int64, _ time.Time, _ int64) {
+	sk.doubleSigns = append(sk.doubleSigns, addr)
+}
+
+func testHandler(ctx sdk.Context, e exported.Evidence) sdk.Error {
+	if e.(testEvidence).Submitted == "invalid" {
+		return types.ErrInvalidEvidence(types.DefaultCodespace, "rejected by handler")
+	}
+	return nil
+}
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper, *mockSlashingKeeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testEvidence{}, "test/Evidence", nil)
+	codec.RegisterCrypto(cdc)
+
+	sk := &mockSlashingKeeper{}
+	k := NewKeeper(cdc, key, sk, types.DefaultCodespace)
+
+	router := types.NewRouter()
+	router.AddRoute("test", testHandler)
+	k.SetRouter(router)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Now().UTC()}, false, log.NewNopLogger())
+	return ctx, k, sk
+}
+
+func TestSetRouterSeals(t *testing.T) {
+	_, k, _ := createTestInput(t)
+	require.True(t, k.router.Sealed())
+	require.Panics(t, func() { k.router.AddRoute("other", testHandler) })
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+
+	e := testEvidence{Height: 5, Submitted: "valid"}
+	require.NoError(t, k.SubmitEvidence(ctx, e))
+
+	res, ok := k.GetEvidence(ctx, e.Hash())
+	require.True(t, ok)
+	require.Equal(t, e, res)
+
+	// duplicate evidence is rejected
+	err := k.SubmitEvidence(ctx, e)
+	require.Error(t, err)
+	require.Equal(t, types.CodeEvidenceExists, err.Code())
+}
+
+func TestSubmitEvidenceInvalid(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+
+	e := testEvidence{Height: 5, Submitted: "invalid"}
+	err := k.SubmitEvidence(ctx, e)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidEvidence, err.Code())
+
+	_, ok := k.GetEvidence(ctx, e.Hash())
+	require.False(t, ok)
+}
+
+func TestSubmitEvidenceNoHandler(t *testing.T) {
+	ctx, k, sk := createTestInput(t)
+
+	// equivocation is handled natively and has no registered route
+	e := types.Equivocation{
+		Height:           5,
+		Time:             ctx.BlockTime(),
+		Power:            100,
+		ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+	err := k.SubmitEvidence(ctx, e)
+	require.Error(t, err)
+	require.Equal(t, types.CodeNoEvidenceHandlerExists, err.Code())
+	require.Empty(t, sk.doubleSigns)
+}
+
+func TestHandleDoubleSign(t *testing.T) {
+	ctx, k, sk := createTestInput(t)
+
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	e := types.Equivocation{Height: 5, Time: ctx.BlockTime(), Power: 100, ConsensusAddress: consAddr}
+
+	k.HandleDoubleSign(ctx, e)
+	require.Equal(t, []crypto.Address{crypto.Address(consAddr)}, sk.doubleSigns)
+
+	res, ok := k.GetEvidence(ctx, e.Hash())
+	require.True(t, ok)
+	require.Equal(t, e, res)
+
+	// the same evidence is not handled twice
+	k.HandleDoubleSign(ctx, e)
+	require.Len(t, sk.doubleSigns, 1)
+}
+
+func TestGetAllEvidence(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, k.SubmitEvidence(ctx, testEvidence{Height: i, Submitted: "valid"}))
+	}
+
+	require.Len(t, k.GetAllEvidence(ctx), 5)
+}
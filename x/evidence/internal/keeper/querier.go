@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+// NewQuerier creates a querier for evidence cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryEvidence:
+			return queryEvidence(ctx, req, k)
+
+		case types.QueryAllEvidence:
+			return queryAllEvidence(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown evidence query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEvidenceParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evidence, ok := k.GetEvidence(ctx, params.EvidenceHash)
+	if !ok {
+		return nil, types.ErrNoEvidenceExists(k.codespace, params.EvidenceHash)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryAllEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAllEvidenceParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	evidence := k.GetAllEvidence(ctx)
+
+	start, end := client.Paginate(len(evidence), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		evidence = []exported.Evidence{}
+	} else {
+		evidence = evidence[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
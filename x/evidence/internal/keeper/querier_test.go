@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+func TestQueryEvidence(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	querier := NewQuerier(k)
+
+	e := testEvidence{Height: 5, Submitted: "valid"}
+	require.NoError(t, k.SubmitEvidence(ctx, e))
+
+	req := abci.RequestQuery{Data: k.cdc.MustMarshalJSON(types.NewQueryEvidenceParams(e.Hash()))}
+	bz, err := querier(ctx, []string{types.QueryEvidence}, req)
+	require.NoError(t, err)
+
+	var res exported.Evidence
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, e, res)
+
+	req = abci.RequestQuery{Data: k.cdc.MustMarshalJSON(types.NewQueryEvidenceParams([]byte("unknown")))}
+	_, err = querier(ctx, []string{types.QueryEvidence}, req)
+	require.Error(t, err)
+}
+
+func TestQueryAllEvidence(t *testing.T) {
+	ctx, k, _ := createTestInput(t)
+	querier := NewQuerier(k)
+
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, k.SubmitEvidence(ctx, testEvidence{Height: i, Submitted: "valid"}))
+	}
+
+	testCases := []struct {
+		page, limit int
+		expected    int
+	}{
+		{1, 10, 5},
+		{1, 2, 2},
+		{3, 2, 1},
+		{4, 2, 0},
+	}
+
+	for i, tc := range testCases {
+		req := abci.RequestQuery{Data: k.cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(tc.page, tc.limit))}
+		bz, err := querier(ctx, []string{types.QueryAllEvidence}, req)
+		require.NoError(t, err, "tc #%d", i)
+
+		var res []exported.Evidence
+		require.NoError(t, k.cdc.UnmarshalJSON(bz, &res), "tc #%d", i)
+		require.Len(t, res, tc.expected, "tc #%d", i)
+	}
+}
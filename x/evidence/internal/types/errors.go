@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoEvidenceHandlerExists sdk.CodeType = 1
+	CodeInvalidEvidence         sdk.CodeType = 2
+	CodeNoEvidenceExists        sdk.CodeType = 3
+	CodeEvidenceExists          sdk.CodeType = 4
+)
+
+// ErrNoEvidenceHandlerExists returns a typed error when no evidence handler is
+// registered for the given evidence route
+func ErrNoEvidenceHandlerExists(codespace sdk.CodespaceType, route string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceHandlerExists, fmt.Sprintf("route '%s' does not have a registered evidence handler", route))
+}
+
+// ErrInvalidEvidence returns a typed error when the evidence is invalid
+func ErrInvalidEvidence(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEvidence, fmt.Sprintf("invalid evidence: %s", msg))
+}
+
+// ErrNoEvidenceExists returns a typed error when no evidence exists for the
+// given hash
+func ErrNoEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeNoEvidenceExists, fmt.Sprintf("evidence with hash %s does not exist", hash))
+}
+
+// ErrEvidenceExists returns a typed error when evidence with the same hash has
+// already been submitted
+func ErrEvidenceExists(codespace sdk.CodespaceType, hash cmn.HexBytes) sdk.Error {
+	return sdk.NewError(codespace, CodeEvidenceExists, fmt.Sprintf("evidence with hash %s already exists", hash))
+}
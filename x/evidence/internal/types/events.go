@@ -0,0 +1,9 @@
+package types
+
+// evidence module events
+const (
+	EventTypeSubmitEvidence = "submit_evidence"
+
+	AttributeValueCategory   = "evidence"
+	AttributeKeyEvidenceHash = "evidence_hash"
+)
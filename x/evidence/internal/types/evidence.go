@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+)
+
+// Evidence type constants
+const (
+	RouteEquivocation = "equivocation"
+	TypeEquivocation  = "equivocation"
+)
+
+var _ exported.ValidatorEvidence = Equivocation{}
+
+// Equivocation implements the Evidence interface and defines evidence of double
+// signing misbehavior reported by Tendermint.
+type Equivocation struct {
+	Height           int64           `json:"height" yaml:"height"`
+	Time             time.Time       `json:"time" yaml:"time"`
+	Power            int64           `json:"power" yaml:"power"`
+	ConsensusAddress sdk.ConsAddress `json:"consensus_address" yaml:"consensus_address"`
+}
+
+// Route returns the Evidence Handler route for an Equivocation type.
+func (e Equivocation) Route() string { return RouteEquivocation }
+
+// Type returns the Evidence Handler type for an Equivocation type.
+func (e Equivocation) Type() string { return TypeEquivocation }
+
+func (e Equivocation) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an Equivocation object.
+func (e Equivocation) Hash() cmn.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an Equivocation object.
+func (e Equivocation) ValidateBasic() sdk.Error {
+	if e.Time.IsZero() {
+		return ErrInvalidEvidence(DefaultCodespace, "invalid equivocation time")
+	}
+	if e.Height < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation height: %d", e.Height))
+	}
+	if e.Power < 1 {
+		return ErrInvalidEvidence(DefaultCodespace, fmt.Sprintf("invalid equivocation validator power: %d", e.Power))
+	}
+	if e.ConsensusAddress.Empty() {
+		return ErrInvalidEvidence(DefaultCodespace, "invalid equivocation validator consensus address")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at time of the
+// Equivocation infraction.
+func (e Equivocation) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height at time of the Equivocation infraction.
+func (e Equivocation) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time at time of the Equivocation infraction.
+func (e Equivocation) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at time of the Equivocation
+// infraction.
+func (e Equivocation) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// ConvertDuplicateVoteEvidence converts a Tendermint concrete Evidence type to
+// SDK Evidence using Equivocation as the concrete type.
+func ConvertDuplicateVoteEvidence(dupVote abci.Evidence) Equivocation {
+	return Equivocation{
+		Height:           dupVote.Height,
+		Power:            dupVote.Validator.Power,
+		ConsensusAddress: sdk.ConsAddress(dupVote.Validator.Address),
+		Time:             dupVote.Time,
+	}
+}
+
+// EvidenceList defines a list of evidence of any type
+type EvidenceList []exported.Evidence
+
+// String implements the Stringer interface
+func (el EvidenceList) String() (out string) {
+	for _, e := range el {
+		out += e.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+func TestEquivocationValidateBasic(t *testing.T) {
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name      string
+		e         types.Equivocation
+		expectErr bool
+	}{
+		{"valid", types.Equivocation{Height: 1, Time: now, Power: 10, ConsensusAddress: consAddr}, false},
+		{"zero time", types.Equivocation{Height: 1, Power: 10, ConsensusAddress: consAddr}, true},
+		{"zero height", types.Equivocation{Height: 0, Time: now, Power: 10, ConsensusAddress: consAddr}, true},
+		{"zero power", types.Equivocation{Height: 1, Time: now, Power: 0, ConsensusAddress: consAddr}, true},
+		{"empty address", types.Equivocation{Height: 1, Time: now, Power: 10}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.e.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestEquivocationHash(t *testing.T) {
+	consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	now := time.Now().UTC()
+
+	e1 := types.Equivocation{Height: 1, Time: now, Power: 10, ConsensusAddress: consAddr}
+	e2 := types.Equivocation{Height: 2, Time: now, Power: 10, ConsensusAddress: consAddr}
+
+	require.Len(t, e1.Hash(), 32)
+	require.Equal(t, e1.Hash(), e1.Hash())
+	require.NotEqual(t, e1.Hash(), e2.Hash())
+}
+
+func TestConvertDuplicateVoteEvidence(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	now := time.Now().UTC()
+
+	dupVote := abci.Evidence{
+		Validator: abci.Validator{Address: pk.Address(), Power: 100},
+		Height:    10,
+		Time:      now,
+	}
+
+	e := types.ConvertDuplicateVoteEvidence(dupVote)
+	require.Equal(t, int64(10), e.GetHeight())
+	require.Equal(t, int64(100), e.GetValidatorPower())
+	require.Equal(t, now, e.GetTime())
+	require.Equal(t, sdk.ConsAddress(pk.Address()), e.GetConsensusAddress())
+	require.NoError(t, e.ValidateBasic())
+}
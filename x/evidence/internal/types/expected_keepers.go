@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// SlashingKeeper defines the slashing module interface contract needed by the
+// evidence module.
+type SlashingKeeper interface {
+	HandleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64)
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+func TestMsgSubmitEvidence(t *testing.T) {
+	pk := ed25519.GenPrivKey()
+	submitter := sdk.AccAddress("test________________")
+	valid := types.Equivocation{
+		Height:           11,
+		Time:             time.Now().UTC(),
+		Power:            100,
+		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address()),
+	}
+
+	testCases := []struct {
+		evidence  exported.Evidence
+		submitter sdk.AccAddress
+		expectErr bool
+	}{
+		{valid, submitter, false},
+		{nil, submitter, true},
+		{types.Equivocation{Height: 0, Time: time.Now().UTC(), Power: 100}, submitter, true},
+		{valid, nil, true},
+	}
+
+	for i, tc := range testCases {
+		msg := types.NewMsgSubmitEvidence(tc.evidence, tc.submitter)
+		require.Equal(t, types.RouterKey, msg.Route(), "unexpected result for tc #%d", i)
+		require.Equal(t, types.TypeMsgSubmitEvidence, msg.Type(), "unexpected result for tc #%d", i)
+		require.Equal(t, tc.expectErr, msg.ValidateBasic() != nil, "unexpected result for tc #%d", i)
+
+		if !tc.expectErr {
+			require.Equal(t, []sdk.AccAddress{tc.submitter}, msg.GetSigners(), "unexpected result for tc #%d", i)
+			require.NotPanics(t, func() { msg.GetSignBytes() }, "unexpected result for tc #%d", i)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// Querier routes for the evidence module
+const (
+	QueryEvidence    = "evidence"
+	QueryAllEvidence = "all_evidence"
+)
+
+// QueryEvidenceParams defines the parameters necessary for querying Evidence.
+type QueryEvidenceParams struct {
+	EvidenceHash cmn.HexBytes `json:"evidence_hash" yaml:"evidence_hash"`
+}
+
+// NewQueryEvidenceParams creates a new QueryEvidenceParams object
+func NewQueryEvidenceParams(hash cmn.HexBytes) QueryEvidenceParams {
+	return QueryEvidenceParams{EvidenceHash: hash}
+}
+
+// QueryAllEvidenceParams defines the parameters necessary for querying for all
+// Evidence.
+type QueryAllEvidenceParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryAllEvidenceParams creates a new QueryAllEvidenceParams object
+func NewQueryAllEvidenceParams(page, limit int) QueryAllEvidenceParams {
+	return QueryAllEvidenceParams{Page: page, Limit: limit}
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/evidence/internal/types"
+)
+
+func testHandler(sdk.Context, exported.Evidence) sdk.Error { return nil }
+
+func TestRouterSeal(t *testing.T) {
+	r := types.NewRouter()
+	r.Seal()
+	require.True(t, r.Sealed())
+	require.Panics(t, func() { r.AddRoute("test", nil) })
+	require.Panics(t, func() { r.Seal() })
+}
+
+func TestRouter(t *testing.T) {
+	r := types.NewRouter()
+	r.AddRoute("test", testHandler)
+	require.True(t, r.HasRoute("test"))
+	require.Panics(t, func() { r.AddRoute("test", testHandler) })
+	require.Panics(t, func() { r.AddRoute("    ", testHandler) })
+	require.NotNil(t, r.GetRoute("test"))
+	require.Panics(t, func() { r.GetRoute("other") })
+}
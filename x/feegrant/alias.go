@@ -0,0 +1,72 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+const (
+	DefaultCodespace          = types.DefaultCodespace
+	CodeNoFeeAllowance        = types.CodeNoFeeAllowance
+	CodeInvalidFeeAllowance   = types.CodeInvalidFeeAllowance
+	CodeFeeLimitExceeded      = types.CodeFeeLimitExceeded
+	CodeFeeAllowanceExpired   = types.CodeFeeAllowanceExpired
+	EventTypeUseFeeGrant      = types.EventTypeUseFeeGrant
+	EventTypeRevokeFeeGrant   = types.EventTypeRevokeFeeGrant
+	EventTypeSetFeeGrant      = types.EventTypeSetFeeGrant
+	AttributeValueCategory    = types.AttributeValueCategory
+	AttributeKeyGranter       = types.AttributeKeyGranter
+	AttributeKeyGrantee       = types.AttributeKeyGrantee
+	ModuleName                = types.ModuleName
+	StoreKey                  = types.StoreKey
+	RouterKey                 = types.RouterKey
+	QuerierRoute              = types.QuerierRoute
+	TypeMsgGrantFeeAllowance  = types.TypeMsgGrantFeeAllowance
+	TypeMsgRevokeFeeAllowance = types.TypeMsgRevokeFeeAllowance
+	QueryFeeAllowance         = types.QueryFeeAllowance
+	QueryFeeAllowances        = types.QueryFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	NewBasicFeeAllowance        = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance     = types.NewPeriodicFeeAllowance
+	NewFeeAllowanceGrant        = types.NewFeeAllowanceGrant
+	RegisterCodec               = types.RegisterCodec
+	ErrNoFeeAllowance           = types.ErrNoFeeAllowance
+	ErrInvalidFeeAllowance      = types.ErrInvalidFeeAllowance
+	ErrFeeLimitExceeded         = types.ErrFeeLimitExceeded
+	ErrFeeAllowanceExpired      = types.ErrFeeAllowanceExpired
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	GetFeeAllowancesKey         = types.GetFeeAllowancesKey
+	GetFeeAllowanceKey          = types.GetFeeAllowanceKey
+	NewMsgGrantFeeAllowance     = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance    = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams  = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams = types.NewQueryFeeAllowancesParams
+
+	// variable aliases
+	ModuleCdc             = types.ModuleCdc
+	FeeAllowanceKeyPrefix = types.FeeAllowanceKeyPrefix
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	FeeAllowanceGrants       = types.FeeAllowanceGrants
+	GenesisState             = types.GenesisState
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
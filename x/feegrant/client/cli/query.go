@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the feegrant module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryFeeAllowance(cdc),
+			GetCmdQueryFeeAllowances(cdc),
+		)...,
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeAllowance implements the query fee allowance command.
+func GetCmdQueryFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter] [grantee]",
+		Short: "Query the fee allowance given by a granter to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the fee allowance given by a granter to a grantee.
+
+Example:
+$ %s query %s allowance cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowanceParams(granter, grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowance)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.FeeAllowanceGrant
+			if err := cdc.UnmarshalJSON(res, &grant); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryFeeAllowances implements the query fee allowances command.
+func GetCmdQueryFeeAllowances(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [grantee]",
+		Short: "Query all the fee allowances given to a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the fee allowances given to a grantee.
+
+Example:
+$ %s query %s allowances cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeAllowancesParams(grantee))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowances)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var grants types.FeeAllowanceGrants
+			if err := cdc.UnmarshalJSON(res, &grants); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+const (
+	flagSpendLimit  = "spend-limit"
+	flagExpiration  = "expiration"
+	flagPeriod      = "period"
+	flagPeriodLimit = "period-limit"
+)
+
+// GetTxCmd returns the transaction commands for the feegrant module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee allowance transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(client.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feegrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance implements the grant fee allowance command.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant an account an allowance to have its fees paid by you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the grantee an allowance to have its transaction fees paid by the
+sender, replacing any existing allowance. The allowance is unlimited unless a
+--spend-limit is given and does not expire unless an --expiration (RFC3339) is
+given. If a --period and --period-limit are given, the fees paid in each period
+are limited as well.
+
+The grantee uses the allowance by passing the sender's address as --fee-granter.
+
+Example:
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --spend-limit 1000stake --from mykey
+$ %s tx %s grant cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --period 24h --period-limit 10stake --from mykey
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			var expiration time.Time
+			if exp := viper.GetString(flagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			var allowance exported.FeeAllowance = types.NewBasicFeeAllowance(spendLimit, expiration)
+
+			period := viper.GetDuration(flagPeriod)
+			periodLimitStr := viper.GetString(flagPeriodLimit)
+			if period != 0 || periodLimitStr != "" {
+				periodLimit, err := sdk.ParseCoins(periodLimitStr)
+				if err != nil {
+					return err
+				}
+
+				allowance = types.NewPeriodicFeeAllowance(types.NewBasicFeeAllowance(spendLimit, expiration), period, periodLimit)
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(cliCtx.GetFromAddress(), grantee, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "The maximum amount of fees that may be paid, unlimited if empty")
+	cmd.Flags().String(flagExpiration, "", "The time (RFC3339) at which the allowance expires")
+	cmd.Flags().Duration(flagPeriod, 0, "The duration of each period of a periodic allowance (e.g. 24h)")
+	cmd.Flags().String(flagPeriodLimit, "", "The maximum amount of fees that may be paid in each period of a periodic allowance")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance implements the revoke fee allowance command.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance given to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance given by the sender to the grantee.
+
+Example:
+$ %s tx %s revoke cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(cliCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/feegrant/grants", grantFeeAllowanceHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/feegrant/revoke", revokeFeeAllowanceHandlerFn(cliCtx)).Methods("POST")
+}
+
+// GrantFeeAllowanceReq defines the properties of a grant fee allowance
+// request's body. The granter is the sender of the base request.
+type GrantFeeAllowanceReq struct {
+	BaseReq   rest.BaseReq          `json:"base_req" yaml:"base_req"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// RevokeFeeAllowanceReq defines the properties of a revoke fee allowance
+// request's body. The granter is the sender of the base request.
+type RevokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+func grantFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granter, req.Grantee, req.Allowance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeFeeAllowanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeFeeAllowanceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granter, req.Grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package feegrant implements fee allowances, which allow one account (the
+granter) to pay the transaction fees of another account (the grantee).
+
+A fee allowance is any type implementing the exported.FeeAllowance interface.
+The module provides a BasicFeeAllowance, which pays fees up to an optional
+spend limit until an optional expiration, and a PeriodicFeeAllowance, which
+additionally limits the fees paid in each period of a given duration.
+
+A granter gives an allowance with a MsgGrantFeeAllowance and removes it with a
+MsgRevokeFeeAllowance. A grantee uses the allowance by setting the granter as
+the fee granter of a transaction's StdFee. The auth ante handler then charges
+the fee against the allowance through the Keeper's UseGrantedFees and deducts
+it from the granter's account instead of the grantee's. Allowances which are
+used up are removed. Expired allowances reject any fee and are kept until the
+granter revokes or replaces them.
+*/
+package feegrant
@@ -0,0 +1,20 @@
+package exported
+
+import (
+	"time"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// FeeAllowance defines a permission given by a granter to a grantee to pay the
+// grantee's transaction fees out of the granter's account.
+type FeeAllowance interface {
+	// Accept determines whether the fee may be paid out of the allowance at the
+	// given block time. If it may, the allowance to store in place of the
+	// current one is returned, or nil if it is unchanged. If remove is true, the
+	// allowance has been used up and is to be deleted.
+	Accept(fee sdk.Coins, blockTime time.Time) (updated FeeAllowance, remove bool, err sdk.Error)
+
+	ValidateBasic() sdk.Error
+	String() string
+}
@@ -0,0 +1,30 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// InitGenesis initializes the feegrant module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, grant := range gs.FeeAllowances {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+}
+
+// ExportGenesis returns the feegrant module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	grants := []FeeAllowanceGrant{}
+	k.IterateAllFeeAllowances(ctx, func(grant FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return NewGenesisState(grants)
+}
@@ -0,0 +1,56 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// NewHandler returns a handler for feegrant messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, k, msg)
+
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, k Keeper, msg MsgGrantFeeAllowance) sdk.Result {
+	k.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, k Keeper, msg MsgRevokeFeeAllowance) sdk.Result {
+	if err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Granter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+// Keeper defines the feegrant module's keeper. The keeper manages the fee
+// allowances given by granters to grantees and charges the fees paid on behalf
+// of grantees against them.
+type Keeper struct {
+	cdc       *codec.Codec
+	storeKey  sdk.StoreKey
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a new feegrant Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		codespace: codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the feegrant module's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GrantFeeAllowance stores the fee allowance grant, replacing any existing
+// allowance given by the same granter to the same grantee.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GetFeeAllowanceKey(grant.Granter, grant.Grantee), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+}
+
+// RevokeFeeAllowance removes the fee allowance given by the granter to the
+// grantee.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	if _, found := k.GetFeeGrant(ctx, granter, grantee); !found {
+		return types.ErrNoFeeAllowance(k.codespace, granter, grantee)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeAllowanceKey(granter, grantee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the fee allowance given by the granter to the
+// grantee, if any.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (exported.FeeAllowance, bool) {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil, false
+	}
+
+	return grant.Allowance, true
+}
+
+// GetFeeGrant returns the fee allowance grant given by the granter to the
+// grantee, if any.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetFeeAllowanceKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the fee allowances given to
+// the grantee. For each grant, cb will be called. If the cb returns true, the
+// iterator will close and stop.
+func (k Keeper) IterateAllGranteeFeeAllowances(
+	ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) bool,
+) {
+
+	k.iterateFeeAllowances(ctx, types.GetFeeAllowancesKey(grantee), cb)
+}
+
+// IterateAllFeeAllowances iterates over all the stored fee allowances. For each
+// grant, cb will be called. If the cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) bool) {
+	k.iterateFeeAllowances(ctx, types.FeeAllowanceKeyPrefix, cb)
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Context, prefix []byte, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees charges the fee against the allowance given by the granter to
+// the grantee. An error is returned if there is no such allowance or it does
+// not accept the fee. An allowance which is used up by the fee is removed.
+// Nothing is written when the fee is rejected, as the state changes of a
+// failing ante handler are discarded, so an expired allowance is kept until the
+// granter revokes or replaces it.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found || grant.Allowance == nil {
+		return types.ErrNoFeeAllowance(k.codespace, granter, grantee)
+	}
+
+	updated, remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	switch {
+	case remove:
+		store.Delete(types.GetFeeAllowanceKey(granter, grantee))
+	case updated != nil:
+		grant.Allowance = updated
+		store.Set(types.GetFeeAllowanceKey(granter, grantee), k.cdc.MustMarshalBinaryLengthPrefixed(grant))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granteeAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	k := NewKeeper(cdc, key, types.DefaultCodespace)
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10, Time: time.Now().UTC()}, false, log.NewNopLogger())
+	return ctx, k
+}
+
+func TestGrantAndRevokeFeeAllowance(t *testing.T) {
+	ctx, k := createTestInput(t)
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Time{})
+
+	_, found := k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.False(t, found)
+
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granterAddr, granteeAddr, allowance))
+	got, found := k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+	require.Equal(t, allowance, got)
+
+	// allowances are directional
+	_, found = k.GetFeeAllowance(ctx, granteeAddr, granterAddr)
+	require.False(t, found)
+
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(otherAddr, granteeAddr, allowance))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granterAddr, otherAddr, allowance))
+
+	var grants []types.FeeAllowanceGrant
+	k.IterateAllGranteeFeeAllowances(ctx, granteeAddr, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+
+	require.NoError(t, k.RevokeFeeAllowance(ctx, granterAddr, granteeAddr))
+	_, found = k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.False(t, found)
+
+	// revoking a missing allowance fails
+	require.Error(t, k.RevokeFeeAllowance(ctx, granterAddr, granteeAddr))
+
+	grants = nil
+	k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	require.Len(t, grants, 2)
+}
+
+func TestUseGrantedFees(t *testing.T) {
+	ctx, k := createTestInput(t)
+	now := ctx.BlockHeader().Time
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+
+	// no allowance
+	require.Error(t, k.UseGrantedFees(ctx, granterAddr, granteeAddr, fee))
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), now.Add(time.Hour))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granterAddr, granteeAddr, allowance))
+
+	// the fee is charged against the allowance
+	require.NoError(t, k.UseGrantedFees(ctx, granterAddr, granteeAddr, fee))
+	got, found := k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), now.Add(time.Hour)), got)
+
+	// fees exceeding the remaining allowance are rejected and leave it intact
+	require.Error(t, k.UseGrantedFees(ctx, granterAddr, granteeAddr, fee))
+	_, found = k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+
+	// exhausting the allowance removes it
+	require.NoError(t, k.UseGrantedFees(ctx, granterAddr, granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	_, found = k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.False(t, found)
+
+	// expired allowances are rejected and kept until they are revoked
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granterAddr, granteeAddr, allowance))
+	require.Error(t, k.UseGrantedFees(ctx.WithBlockTime(now.Add(time.Hour)), granterAddr, granteeAddr, fee))
+	got, found = k.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+	require.Equal(t, allowance, got)
+	require.NoError(t, k.RevokeFeeAllowance(ctx, granterAddr, granteeAddr))
+}
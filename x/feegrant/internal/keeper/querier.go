@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+// NewQuerier creates a querier for feegrant cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryFeeAllowance:
+			return queryFeeAllowance(ctx, req, k)
+
+		case types.QueryFeeAllowances:
+			return queryFeeAllowances(ctx, req, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown feegrant query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryFeeAllowance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeAllowanceParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grant, found := k.GetFeeGrant(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, types.ErrNoFeeAllowance(k.codespace, params.Granter, params.Grantee)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryFeeAllowances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeAllowancesParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	grants := []types.FeeAllowanceGrant{}
+	k.IterateAllGranteeFeeAllowances(ctx, params.Grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	res, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/internal/types"
+)
+
+func TestQueryFeeAllowances(t *testing.T) {
+	ctx, k := createTestInput(t)
+	querier := NewQuerier(k)
+
+	allowance := types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Time{})
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(granterAddr, granteeAddr, allowance))
+	k.GrantFeeAllowance(ctx, types.NewFeeAllowanceGrant(otherAddr, granteeAddr, allowance))
+
+	req := abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(granterAddr, granteeAddr)),
+	}
+	bz, err := querier(ctx, []string{types.QueryFeeAllowance}, req)
+	require.NoError(t, err)
+
+	var grant types.FeeAllowanceGrant
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &grant))
+	require.Equal(t, types.NewFeeAllowanceGrant(granterAddr, granteeAddr, allowance), grant)
+
+	req = abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryFeeAllowanceParams(granteeAddr, granterAddr)),
+	}
+	_, err = querier(ctx, []string{types.QueryFeeAllowance}, req)
+	require.Error(t, err)
+
+	req = abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryFeeAllowancesParams(granteeAddr)),
+	}
+	bz, err = querier(ctx, []string{types.QueryFeeAllowances}, req)
+	require.NoError(t, err)
+
+	var grants []types.FeeAllowanceGrant
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &grants))
+	require.Len(t, grants, 2)
+
+	_, err = querier(ctx, []string{"unknown"}, req)
+	require.Error(t, err)
+}
@@ -0,0 +1,235 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+)
+
+var (
+	_ exported.FeeAllowance = BasicFeeAllowance{}
+	_ exported.FeeAllowance = PeriodicFeeAllowance{}
+)
+
+// BasicFeeAllowance allows the grantee to have fees paid up to SpendLimit
+// until Expiration. An empty spend limit is unlimited and a zero expiration
+// never expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time `json:"expiration" yaml:"expiration"`
+}
+
+// NewBasicFeeAllowance creates a new BasicFeeAllowance object.
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration time.Time) BasicFeeAllowance {
+	return BasicFeeAllowance{SpendLimit: spendLimit, Expiration: expiration}
+}
+
+// IsExpired returns true if the allowance has an expiration which is at or
+// before the given time.
+func (a BasicFeeAllowance) IsExpired(t time.Time) bool {
+	return !a.Expiration.IsZero() && !a.Expiration.After(t)
+}
+
+// Accept implements the FeeAllowance interface. The fee is deducted from the
+// spend limit and the allowance is removed once the limit is exhausted.
+func (a BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (exported.FeeAllowance, bool, sdk.Error) {
+	if a.IsExpired(blockTime) {
+		return nil, false, ErrFeeAllowanceExpired(DefaultCodespace)
+	}
+
+	if a.SpendLimit.Empty() {
+		return nil, false, nil
+	}
+
+	left, isNegative := a.SpendLimit.SafeSub(fee)
+	if isNegative {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.SpendLimit)
+	}
+
+	return NewBasicFeeAllowance(left, a.Expiration), left.IsZero(), nil
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, fmt.Sprintf("invalid spend limit %s", a.SpendLimit))
+	}
+	if !a.SpendLimit.Empty() && !a.SpendLimit.IsAllPositive() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "spend limit must be positive")
+	}
+
+	return nil
+}
+
+// String implements the FeeAllowance interface.
+func (a BasicFeeAllowance) String() string {
+	return fmt.Sprintf(`Basic Fee Allowance:
+  Spend Limit: %s
+  Expiration:  %s`, a.SpendLimit, a.Expiration)
+}
+
+// PeriodicFeeAllowance extends a BasicFeeAllowance with a limit on the fees
+// that may be paid in each period. PeriodCanSpend is what is left to be spent
+// in the current period, which ends at PeriodReset. When a period ends,
+// PeriodCanSpend is reset to PeriodSpendLimit, capped by what is left of the
+// basic spend limit.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic" yaml:"basic"`
+	Period           time.Duration     `json:"period" yaml:"period"`
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit" yaml:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend" yaml:"period_can_spend"`
+	PeriodReset      time.Time         `json:"period_reset" yaml:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance creates a new PeriodicFeeAllowance object whose first
+// period starts at the first use of the allowance.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period time.Duration, periodSpendLimit sdk.Coins) PeriodicFeeAllowance {
+	return PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements the FeeAllowance interface. The fee is deducted from both
+// what is left in the current period and the basic spend limit.
+func (a PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (exported.FeeAllowance, bool, sdk.Error) {
+	if a.Basic.IsExpired(blockTime) {
+		return nil, false, ErrFeeAllowanceExpired(DefaultCodespace)
+	}
+
+	a = a.tryResetPeriod(blockTime)
+
+	canSpend, isNegative := a.PeriodCanSpend.SafeSub(fee)
+	if isNegative {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.PeriodCanSpend)
+	}
+	a.PeriodCanSpend = canSpend
+
+	if a.Basic.SpendLimit.Empty() {
+		return a, false, nil
+	}
+
+	left, isNegative := a.Basic.SpendLimit.SafeSub(fee)
+	if isNegative {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.Basic.SpendLimit)
+	}
+	a.Basic.SpendLimit = left
+
+	return a, left.IsZero(), nil
+}
+
+// tryResetPeriod starts a new period if the current one has ended at the given
+// block time. The next period starts where the current one ended, unless more
+// than a full period has passed, in which case it starts at the block time.
+func (a PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) PeriodicFeeAllowance {
+	if blockTime.Before(a.PeriodReset) {
+		return a
+	}
+
+	// set what can be spent to the lesser of the period and basic spend limits
+	if _, isNegative := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNegative && !a.Basic.SpendLimit.Empty() {
+		a.PeriodCanSpend = a.Basic.SpendLimit
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if !blockTime.Before(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+
+	return a
+}
+
+// ValidateBasic implements the FeeAllowance interface.
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "period must be positive")
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, fmt.Sprintf("invalid period spend limit %s", a.PeriodSpendLimit))
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return ErrInvalidFeeAllowance(DefaultCodespace, fmt.Sprintf("invalid period can spend %s", a.PeriodCanSpend))
+	}
+	if !a.Basic.SpendLimit.Empty() && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "period spend limit has denoms not in the spend limit")
+	}
+
+	return nil
+}
+
+// String implements the FeeAllowance interface.
+func (a PeriodicFeeAllowance) String() string {
+	return fmt.Sprintf(`Periodic Fee Allowance:
+  Spend Limit:        %s
+  Expiration:         %s
+  Period:             %s
+  Period Spend Limit: %s
+  Period Can Spend:   %s
+  Period Reset:       %s`,
+		a.Basic.SpendLimit, a.Basic.Expiration, a.Period,
+		a.PeriodSpendLimit, a.PeriodCanSpend, a.PeriodReset,
+	)
+}
+
+// FeeAllowanceGrant is a fee allowance along with its granter and grantee, as
+// persisted by the keeper and used in genesis and query responses.
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant object.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance exported.FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic performs basic validation of the grant.
+func (g FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	if g.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if g.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if g.Granter.Equals(g.Grantee) {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "granter and grantee cannot be the same")
+	}
+	if g.Allowance == nil {
+		return ErrInvalidFeeAllowance(DefaultCodespace, "missing allowance")
+	}
+
+	return g.Allowance.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (g FeeAllowanceGrant) String() string {
+	return fmt.Sprintf(`Granter: %s
+Grantee: %s
+%s`, g.Granter, g.Grantee, g.Allowance)
+}
+
+// FeeAllowanceGrants defines a list of FeeAllowanceGrant objects.
+type FeeAllowanceGrants []FeeAllowanceGrant
+
+// String implements the Stringer interface.
+func (gs FeeAllowanceGrants) String() (out string) {
+	for _, g := range gs {
+		out += g.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
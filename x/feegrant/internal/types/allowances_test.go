@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestBasicFeeAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// unlimited allowances accept any fee and are kept
+	unlimited := NewBasicFeeAllowance(nil, time.Time{})
+	require.NoError(t, unlimited.ValidateBasic())
+	updated, remove, err := unlimited.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Nil(t, updated)
+
+	allowance := NewBasicFeeAllowance(limit, now.Add(time.Hour))
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the fee is deducted from the spend limit
+	updated, remove, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), now.Add(time.Hour)), updated)
+
+	// fees over the limit or in other denoms are rejected
+	_, remove, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), now)
+	require.Error(t, err)
+	require.False(t, remove)
+	_, _, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), now)
+	require.Error(t, err)
+
+	// exhausting the limit removes the allowance
+	_, remove, err = allowance.Accept(limit, now)
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	// expired allowances reject any fee
+	_, remove, err = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), now.Add(time.Hour))
+	require.Error(t, err)
+	require.False(t, remove)
+
+	require.Error(t, NewBasicFeeAllowance(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, now).ValidateBasic())
+}
+
+func TestPeriodicFeeAllowance(t *testing.T) {
+	now := time.Now().UTC()
+	basic := NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), time.Time{})
+	allowance := NewPeriodicFeeAllowance(basic, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the first use starts the first period
+	updated, remove, err := allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 6)), now)
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	periodic := updated.(PeriodicFeeAllowance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), periodic.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 19)), periodic.Basic.SpendLimit)
+	require.True(t, now.Add(time.Hour).Equal(periodic.PeriodReset))
+
+	// fees over what is left in the period are rejected
+	_, _, err = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), now.Add(time.Minute))
+	require.Error(t, err)
+
+	// the next period starts where the previous one ended
+	updated, remove, err = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), now.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	periodic = updated.(PeriodicFeeAllowance)
+	require.True(t, periodic.PeriodCanSpend.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), periodic.Basic.SpendLimit)
+	require.True(t, now.Add(2*time.Hour).Equal(periodic.PeriodReset))
+
+	// a period is capped by what is left of the spend limit, and exhausting
+	// the spend limit removes the allowance
+	updated, remove, err = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), now.Add(5*time.Hour))
+	require.NoError(t, err)
+	require.True(t, remove)
+
+	periodic = updated.(PeriodicFeeAllowance)
+	require.True(t, now.Add(6*time.Hour).Equal(periodic.PeriodReset))
+
+	require.Error(t, NewPeriodicFeeAllowance(basic, 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, time.Hour, nil).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(basic, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("atom", 10))).ValidateBasic())
+}
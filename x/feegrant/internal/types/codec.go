@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+)
+
+// ModuleCdc defines the feegrant module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// feegrant module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*exported.FeeAllowance)(nil), nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "cosmos-sdk/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "cosmos-sdk/MsgRevokeFeeAllowance", nil)
+	cdc.RegisterConcrete(BasicFeeAllowance{}, "cosmos-sdk/BasicFeeAllowance", nil)
+	cdc.RegisterConcrete(PeriodicFeeAllowance{}, "cosmos-sdk/PeriodicFeeAllowance", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoFeeAllowance      sdk.CodeType = 1
+	CodeInvalidFeeAllowance sdk.CodeType = 2
+	CodeFeeLimitExceeded    sdk.CodeType = 3
+	CodeFeeAllowanceExpired sdk.CodeType = 4
+)
+
+// ErrNoFeeAllowance returns a typed error when no fee allowance exists for the
+// given granter and grantee
+func ErrNoFeeAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoFeeAllowance,
+		fmt.Sprintf("no fee allowance from granter %s to grantee %s", granter, grantee))
+}
+
+// ErrInvalidFeeAllowance returns a typed error when a fee allowance is invalid
+func ErrInvalidFeeAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, fmt.Sprintf("invalid fee allowance: %s", msg))
+}
+
+// ErrFeeLimitExceeded returns a typed error when a fee exceeds the amount left
+// in a fee allowance
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, fee, limit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee %s exceeds allowance limit %s", fee, limit))
+}
+
+// ErrFeeAllowanceExpired returns a typed error when a fee allowance has expired
+func ErrFeeAllowanceExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceExpired, "fee allowance expired")
+}
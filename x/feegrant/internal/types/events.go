@@ -0,0 +1,12 @@
+package types
+
+// feegrant module events
+const (
+	EventTypeUseFeeGrant    = "use_fee_grant"
+	EventTypeRevokeFeeGrant = "revoke_fee_grant"
+	EventTypeSetFeeGrant    = "set_fee_grant"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for feegrant store
+// Items are stored with the following key: values
+//
+// - 0x00<grantee_Bytes><granter_Bytes>: FeeAllowanceGrant
+var (
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// GetFeeAllowancesKey returns the prefix of all the fee allowances given to a
+// grantee
+func GetFeeAllowancesKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
+
+// GetFeeAllowanceKey returns the key of the fee allowance given by a granter to
+// a grantee
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesKey(grantee), granter.Bytes()...)
+}
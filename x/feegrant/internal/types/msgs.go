@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/feegrant/exported"
+)
+
+// Message types for the feegrant module
+const (
+	TypeMsgGrantFeeAllowance  = "grant_fee_allowance"
+	TypeMsgRevokeFeeAllowance = "revoke_fee_allowance"
+)
+
+var (
+	_ sdk.Msg = MsgGrantFeeAllowance{}
+	_ sdk.Msg = MsgRevokeFeeAllowance{}
+)
+
+// MsgGrantFeeAllowance gives the grantee an allowance to have its fees paid
+// out of the granter's account, replacing any existing allowance between them.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress        `json:"granter" yaml:"granter"`
+	Grantee   sdk.AccAddress        `json:"grantee" yaml:"grantee"`
+	Allowance exported.FeeAllowance `json:"allowance" yaml:"allowance"`
+}
+
+// NewMsgGrantFeeAllowance creates a new MsgGrantFeeAllowance object.
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance exported.FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) Type() string { return TypeMsgGrantFeeAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	return NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance).ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeFeeAllowance removes the fee allowance given by the granter to the
+// grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeFeeAllowance creates a new MsgRevokeFeeAllowance object.
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) Type() string { return TypeMsgRevokeFeeAllowance }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	allowance := NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Time{})
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgGrantFeeAllowance
+	}{
+		{true, NewMsgGrantFeeAllowance(granter, grantee, allowance)},
+		{true, NewMsgGrantFeeAllowance(granter, grantee, NewPeriodicFeeAllowance(allowance, time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))},
+		{false, NewMsgGrantFeeAllowance(emptyAddr, grantee, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, emptyAddr, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, granter, allowance)},
+		{false, NewMsgGrantFeeAllowance(granter, grantee, nil)},
+		{false, NewMsgGrantFeeAllowance(granter, grantee, NewPeriodicFeeAllowance(allowance, 0, nil))},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgGrantFeeAllowance(granter, grantee, allowance)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgGrantFeeAllowance, msg.Type())
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgRevokeFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgRevokeFeeAllowance
+	}{
+		{true, NewMsgRevokeFeeAllowance(granter, grantee)},
+		{false, NewMsgRevokeFeeAllowance(emptyAddr, grantee)},
+		{false, NewMsgRevokeFeeAllowance(granter, emptyAddr)},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgRevokeFeeAllowance(granter, grantee)
+	require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+}
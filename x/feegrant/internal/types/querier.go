@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Querier routes for the feegrant module
+const (
+	QueryFeeAllowance  = "fee_allowance"
+	QueryFeeAllowances = "fee_allowances"
+)
+
+// QueryFeeAllowanceParams defines the parameters necessary for querying the
+// fee allowance given by a granter to a grantee.
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new QueryFeeAllowanceParams object
+func NewQueryFeeAllowanceParams(granter, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryFeeAllowancesParams defines the parameters necessary for querying all
+// the fee allowances given to a grantee.
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams creates a new QueryFeeAllowancesParams object
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
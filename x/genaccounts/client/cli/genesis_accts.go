@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +15,7 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/codec"
 	"github.com/hyperspeednetwork/hsnhub/server"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
 	"github.com/hyperspeednetwork/hsnhub/x/genaccounts"
 	"github.com/hyperspeednetwork/hsnhub/x/genutil"
 )
@@ -20,8 +25,24 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingPrds  = "vesting-periods"
+	flagVestingFile  = "vesting-schedule"
 )
 
+// VestingSchedule defines the format of a periodic vesting schedule file. Each
+// period vests its coins once its length (in seconds) has elapsed since the end
+// of the previous period, or since the start time for the first period.
+type VestingSchedule struct {
+	StartTime int64           `json:"start_time"`
+	Periods   []VestingPeriod `json:"periods"`
+}
+
+// VestingPeriod defines a single period of a vesting schedule file
+type VestingPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 	defaultNodeHome, defaultClientHome string) *cobra.Command {
@@ -60,7 +81,36 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 				return err
 			}
 
+			var vestingPeriods auth.Periods
+			if scheduleFile := viper.GetString(flagVestingFile); scheduleFile != "" {
+				if viper.GetString(flagVestingPrds) != "" {
+					return fmt.Errorf("--%s and --%s cannot be used together", flagVestingFile, flagVestingPrds)
+				}
+
+				vestingStart, vestingPeriods, err = parseVestingScheduleFile(scheduleFile)
+				if err != nil {
+					return err
+				}
+			} else if periods := viper.GetString(flagVestingPrds); periods != "" {
+				vestingPeriods, err = parseVestingPeriods(periods)
+				if err != nil {
+					return err
+				}
+			}
+
+			// the vesting amount and end time of a periodic vesting account are
+			// derived from its periods
+			if len(vestingPeriods) > 0 {
+				if vestingAmt.IsZero() {
+					vestingAmt = vestingPeriods.TotalAmount()
+				}
+				if vestingEnd == 0 {
+					vestingEnd = vestingStart + vestingPeriods.TotalLength()
+				}
+			}
+
 			genAcc := genaccounts.NewGenesisAccountRaw(addr, coins, vestingAmt, vestingStart, vestingEnd, "", "")
+			genAcc.VestingPeriods = vestingPeriods
 			if err := genAcc.Validate(); err != nil {
 				return err
 			}
@@ -103,5 +153,64 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPrds, "", "semicolon separated list of [length_seconds]:[coin][,[coin]] periods for periodic vesting accounts")
+	cmd.Flags().String(flagVestingFile, "", "path to a JSON periodic vesting schedule file, overrides the vesting start time")
 	return cmd
 }
+
+// parseVestingPeriods parses periods of the form
+// [length_seconds]:[coin][,[coin]];[length_seconds]:[coin][,[coin]]...
+func parseVestingPeriods(periodsStr string) (auth.Periods, error) {
+	var periods auth.Periods
+
+	for _, periodStr := range strings.Split(strings.TrimSpace(periodsStr), ";") {
+		parts := strings.SplitN(strings.TrimSpace(periodStr), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid vesting period %q, expected [length_seconds]:[coins]", periodStr)
+		}
+
+		length, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vesting period length %q: %v", parts[0], err)
+		}
+
+		amount, err := sdk.ParseCoins(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		periods = append(periods, auth.NewPeriod(length, amount))
+	}
+
+	return periods, periods.Validate()
+}
+
+// parseVestingScheduleFile reads a periodic vesting schedule from a JSON file
+// and returns its start time and periods.
+func parseVestingScheduleFile(path string) (int64, auth.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var schedule VestingSchedule
+	if err := json.Unmarshal(bz, &schedule); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse vesting schedule file: %v", err)
+	}
+
+	if len(schedule.Periods) == 0 {
+		return 0, nil, fmt.Errorf("vesting schedule file %s has no periods", path)
+	}
+
+	periods := make(auth.Periods, len(schedule.Periods))
+	for i, p := range schedule.Periods {
+		amount, err := sdk.ParseCoins(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		periods[i] = auth.NewPeriod(p.Length, amount)
+	}
+
+	return schedule.StartTime, periods, periods.Validate()
+}
@@ -65,6 +65,49 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisAccountValidatePeriods(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	periods := authtypes.Periods{
+		authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+		authtypes.NewPeriod(100, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+	}
+
+	newPeriodicAcc := func(vestingAmt sdk.Coins, end int64, periods authtypes.Periods) GenesisAccount {
+		acc := NewGenesisAccountRaw(addr, coins, vestingAmt, 1000, end, "", "")
+		acc.VestingPeriods = periods
+		return acc
+	}
+
+	tests := []struct {
+		name   string
+		acc    GenesisAccount
+		expErr error
+	}{
+		{
+			"valid periodic vesting account",
+			newPeriodicAcc(coins, 1200, periods),
+			nil,
+		},
+		{
+			"invalid vesting periods amount",
+			newPeriodicAcc(sdk.NewCoins(sdk.NewInt64Coin("stake", 80)), 1200, periods),
+			errors.New("vesting periods amount must be equal to the vesting amount"),
+		},
+		{
+			"invalid vesting periods length",
+			newPeriodicAcc(coins, 1300, periods),
+			errors.New("vesting periods length must be equal to the vesting duration"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.acc.Validate()
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
 func TestToAccount(t *testing.T) {
 	priv := ed25519.GenPrivKey()
 	addr := sdk.AccAddress(priv.PubKey().Address())
@@ -87,6 +130,20 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &authtypes.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*authtypes.ContinuousVestingAccount))
 
+	// periodic vesting account
+	authAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)))
+	periods := authtypes.Periods{
+		authtypes.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+		authtypes.NewPeriod(7200, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))),
+	}
+	pvacc := authtypes.NewPeriodicVestingAccount(&authAcc, time.Now().Unix(), periods)
+	genAcc, err = NewGenesisAccountI(pvacc)
+	require.NoError(t, err)
+	require.NoError(t, genAcc.Validate())
+	acc = genAcc.ToAccount()
+	require.IsType(t, &authtypes.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pvacc, acc.(*authtypes.PeriodicVestingAccount))
+
 	// module account
 	macc := supply.NewEmptyModuleAccount("mint", supply.Minter)
 	genAcc, err = NewGenesisAccountI(macc)
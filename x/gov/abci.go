@@ -24,11 +24,12 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			),
 		)
 
+		depositParams, _ := keeper.GetProposalParams(ctx, proposal.Content)
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				depositParams.GetMinDeposit(proposal.IsExpedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -39,22 +40,33 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// The votes are deleted while tallying. An expedited proposal failing
+		// its threshold is converted to a regular proposal that keeps its votes
+		// and deposits, so its tally writes are discarded in that case.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+		convertToRegular := proposal.IsExpedited && !passes
 
-		if burnDeposits {
-			keeper.DeleteDeposits(ctx, proposal.ProposalID)
-		} else {
-			keeper.RefundDeposits(ctx, proposal.ProposalID)
+		if !convertToRegular {
+			writeTally()
+
+			if burnDeposits {
+				keeper.DeleteDeposits(ctx, proposal.ProposalID)
+			} else {
+				keeper.RefundDeposits(ctx, proposal.ProposalID)
+			}
 		}
 
-		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+		switch {
+		case passes:
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			err := keeper.HandleProposalContent(cacheCtx, proposal.Content)
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -67,7 +79,17 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err.ABCILog())
 			}
-		} else {
+
+		case convertToRegular:
+			// The voting period is extended to the one of a regular proposal
+			// and the proposal is tallied again once it ends.
+			proposal.IsExpedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+			tagValue = types.AttributeValueExpeditedProposalRejected
+			logMsg = "expedited proposal converted to regular"
+
+		default:
 			proposal.Status = StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
@@ -76,7 +98,6 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		proposal.FinalTallyResult = tallyResults
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 
 		logger.Info(
 			fmt.Sprintf(
@@ -325,3 +325,121 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestExpeditedProposalPassed(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, input.sk)
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+	require.True(t, proposal.IsExpedited)
+
+	// the regular minimum deposit does not activate an expedited proposal
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(25)))
+	res := handler(ctx, NewMsgDeposit(input.addrs[0], proposal.ProposalID, proposalCoins))
+	require.True(t, res.IsOK())
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusDepositPeriod, proposal.Status)
+
+	res = handler(ctx, NewMsgDeposit(input.addrs[1], proposal.ProposalID, proposalCoins))
+	require.True(t, res.IsOK())
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetVotingParams(ctx).ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionYes))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.True(t, proposal.IsExpedited)
+}
+
+func TestExpeditedProposalConvertedToRegular(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, input.sk)
+
+	macc := input.keeper.GetGovernanceAccount(ctx)
+	require.NotNil(t, macc)
+	initialModuleAccCoins := macc.GetCoins()
+
+	proposal, err := input.keeper.SubmitExpeditedProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(25)))
+	for _, addr := range input.addrs {
+		res := handler(ctx, NewMsgDeposit(addr, proposal.ProposalID, proposalCoins))
+		require.True(t, res.IsOK())
+	}
+
+	// 60% of the voting power votes yes, which is above the regular threshold
+	// but below the expedited one
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposal.ProposalID, input.addrs[1], OptionNo))
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.IsExpedited)
+	require.Equal(t, proposal.VotingStartTime.Add(input.keeper.GetVotingParams(ctx).VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, input.keeper.GetVotes(ctx, proposal.ProposalID), 2)
+	require.Len(t, input.keeper.GetDeposits(ctx, proposal.ProposalID), 2)
+
+	activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+
+	macc = input.keeper.GetGovernanceAccount(ctx)
+	require.NotNil(t, macc)
+	require.True(t, macc.GetCoins().IsEqual(initialModuleAccCoins))
+}
@@ -25,7 +25,11 @@ const (
 	CodeInvalidGenesis           = types.CodeInvalidGenesis
 	CodeInvalidProposalStatus    = types.CodeInvalidProposalStatus
 	CodeProposalHandlerNotExists = types.CodeProposalHandlerNotExists
+	CodeInvalidProposalMsg       = types.CodeInvalidProposalMsg
+	CodeInvalidProposer          = types.CodeInvalidProposer
+	CodeInvalidCancelRatio       = types.CodeInvalidCancelRatio
 	DefaultPeriod                = types.DefaultPeriod
+	DefaultExpeditedPeriod       = types.DefaultExpeditedPeriod
 	ModuleName                   = types.ModuleName
 	StoreKey                     = types.StoreKey
 	RouterKey                    = types.RouterKey
@@ -33,15 +37,18 @@ const (
 	DefaultParamspace            = types.DefaultParamspace
 	TypeMsgDeposit               = types.TypeMsgDeposit
 	TypeMsgVote                  = types.TypeMsgVote
+	TypeMsgVoteWeighted          = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal        = types.TypeMsgSubmitProposal
+	TypeMsgCancelProposal        = types.TypeMsgCancelProposal
 	StatusNil                    = types.StatusNil
 	StatusDepositPeriod          = types.StatusDepositPeriod
 	StatusVotingPeriod           = types.StatusVotingPeriod
 	StatusPassed                 = types.StatusPassed
 	StatusRejected               = types.StatusRejected
 	StatusFailed                 = types.StatusFailed
+	StatusCanceled               = types.StatusCanceled
 	ProposalTypeText             = types.ProposalTypeText
-	ProposalTypeSoftwareUpgrade  = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeExec             = types.ProposalTypeExec
 	QueryParams                  = types.QueryParams
 	QueryProposals               = types.QueryProposals
 	QueryProposal                = types.QueryProposal
@@ -53,6 +60,7 @@ const (
 	ParamDeposit                 = types.ParamDeposit
 	ParamVoting                  = types.ParamVoting
 	ParamTallying                = types.ParamTallying
+	ParamProposalType            = types.ParamProposalType
 	OptionEmpty                  = types.OptionEmpty
 	OptionYes                    = types.OptionYes
 	OptionAbstain                = types.OptionAbstain
@@ -69,6 +77,7 @@ var (
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
+	RegisterProposalMsgCodec      = types.RegisterProposalMsgCodec
 	ValidateAbstract              = types.ValidateAbstract
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
@@ -77,8 +86,13 @@ var (
 	ErrInvalidProposalContent     = types.ErrInvalidProposalContent
 	ErrInvalidProposalType        = types.ErrInvalidProposalType
 	ErrInvalidVote                = types.ErrInvalidVote
+	ErrInvalidWeightedVote        = types.ErrInvalidWeightedVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposalMsg         = types.ErrInvalidProposalMsg
+	ErrInvalidProposer            = types.ErrInvalidProposer
+	ErrInvalidCancelRatio         = types.ErrInvalidCancelRatio
+	ErrAlreadyFinishedProposal    = types.ErrAlreadyFinishedProposal
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -99,11 +113,15 @@ var (
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
+	NewMsgSubmitExpeditedProposal = types.NewMsgSubmitExpeditedProposal
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgCancelProposal          = types.NewMsgCancelProposal
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
+	NewProposalTypeParams         = types.NewProposalTypeParams
 	NewVotingParams               = types.NewVotingParams
 	NewParams                     = types.NewParams
 	NewProposal                   = types.NewProposal
@@ -111,7 +129,9 @@ var (
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
 	NewTextProposal               = types.NewTextProposal
-	NewSoftwareUpgradeProposal    = types.NewSoftwareUpgradeProposal
+	NewExecProposal               = types.NewExecProposal
+	RegisterMsgProposalType       = types.RegisterMsgProposalType
+	ExecMsgSubtype                = types.ExecMsgSubtype
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
 	IsValidProposalType           = types.IsValidProposalType
@@ -125,49 +145,64 @@ var (
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
 
 	// variable aliases
-	ModuleCdc                   = types.ModuleCdc
-	ProposalsKeyPrefix          = types.ProposalsKeyPrefix
-	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
-	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
-	ProposalIDKey               = types.ProposalIDKey
-	DepositsKeyPrefix           = types.DepositsKeyPrefix
-	VotesKeyPrefix              = types.VotesKeyPrefix
-	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
-	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
-	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
+	ModuleCdc                       = types.ModuleCdc
+	ProposalsKeyPrefix              = types.ProposalsKeyPrefix
+	ActiveProposalQueuePrefix       = types.ActiveProposalQueuePrefix
+	InactiveProposalQueuePrefix     = types.InactiveProposalQueuePrefix
+	ProposalIDKey                   = types.ProposalIDKey
+	DepositsKeyPrefix               = types.DepositsKeyPrefix
+	VotesKeyPrefix                  = types.VotesKeyPrefix
+	ParamStoreKeyDepositParams      = types.ParamStoreKeyDepositParams
+	ParamStoreKeyVotingParams       = types.ParamStoreKeyVotingParams
+	ParamStoreKeyTallyParams        = types.ParamStoreKeyTallyParams
+	ParamStoreKeyProposalTypeParams = types.ParamStoreKeyProposalTypeParams
+	DefaultExpeditedThreshold       = types.DefaultExpeditedThreshold
+	DefaultProposalCancelRatio      = types.DefaultProposalCancelRatio
 )
 
 type (
-	Keeper                  = keeper.Keeper
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	GenesisState            = types.GenesisState
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	DepositParams           = types.DepositParams
-	TallyParams             = types.TallyParams
-	VotingParams            = types.VotingParams
-	Params                  = types.Params
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	QueryProposalParams     = types.QueryProposalParams
-	QueryDepositParams      = types.QueryDepositParams
-	QueryVoteParams         = types.QueryVoteParams
-	QueryProposalsParams    = types.QueryProposalsParams
-	ValidatorGovInfo        = types.ValidatorGovInfo
-	TallyResult             = types.TallyResult
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Keeper                 = keeper.Keeper
+	Content                = types.Content
+	SubtypedContent        = types.SubtypedContent
+	Handler                = types.Handler
+	Deposit                = types.Deposit
+	Deposits               = types.Deposits
+	GenesisState           = types.GenesisState
+	MsgSubmitProposal      = types.MsgSubmitProposal
+	MsgDeposit             = types.MsgDeposit
+	MsgVote                = types.MsgVote
+	MsgVoteWeighted        = types.MsgVoteWeighted
+	MsgCancelProposal      = types.MsgCancelProposal
+	DepositParams          = types.DepositParams
+	TallyParams            = types.TallyParams
+	ProposalTypeParams     = types.ProposalTypeParams
+	ProposalTypeParamsList = types.ProposalTypeParamsList
+	VotingParams           = types.VotingParams
+	Params                 = types.Params
+	Proposal               = types.Proposal
+	Proposals              = types.Proposals
+	ProposalQueue          = types.ProposalQueue
+	ProposalStatus         = types.ProposalStatus
+	TextProposal           = types.TextProposal
+	ExecProposal           = types.ExecProposal
+	QueryProposalParams    = types.QueryProposalParams
+	QueryDepositParams     = types.QueryDepositParams
+	QueryVoteParams        = types.QueryVoteParams
+	QueryProposalsParams   = types.QueryProposalsParams
+	ValidatorGovInfo       = types.ValidatorGovInfo
+	TallyResult            = types.TallyResult
+	Vote                   = types.Vote
+	Votes                  = types.Votes
+	WeightedVoteOption     = types.WeightedVoteOption
+	WeightedVoteOptions    = types.WeightedVoteOptions
+	VoteOption             = types.VoteOption
 )
@@ -19,6 +19,7 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(viper.GetString(flagProposalType))
 		proposal.Deposit = viper.GetString(FlagDeposit)
+		proposal.Expedited = viper.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -92,7 +92,7 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected|Canceled)
 `,
 				version.ClientName, version.ClientName, version.ClientName,
 			),
@@ -162,7 +162,7 @@ $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 	cmd.Flags().String(flagNumLimit, "", "(optional) limit to latest [number] proposals. Defaults to all proposals")
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/canceled")
 
 	return cmd
 }
@@ -469,7 +469,8 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		Use:   "params",
 		Short: "Query the parameters of the governance process",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the all the parameters for the governance process.
+			fmt.Sprintf(`Query the all the parameters for the governance process, including the
+effective parameters of each proposal type having its own parameters.
 
 Example:
 $ %s query gov params
@@ -492,6 +493,10 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
+			ptp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/proposal_type", queryRoute), nil)
+			if err != nil {
+				return err
+			}
 
 			var tallyParams types.TallyParams
 			cdc.MustUnmarshalJSON(tp, &tallyParams)
@@ -499,8 +504,10 @@ $ %s query gov params
 			cdc.MustUnmarshalJSON(dp, &depositParams)
 			var votingParams types.VotingParams
 			cdc.MustUnmarshalJSON(vp, &votingParams)
+			var proposalTypeParams types.ProposalTypeParamsList
+			cdc.MustUnmarshalJSON(ptp, &proposalTypeParams)
 
-			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams))
+			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams, proposalTypeParams))
 		},
 	}
 }
@@ -510,7 +517,7 @@ func GetCmdQueryParam(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|proposal_type) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -518,8 +525,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param proposal_type
 `,
-				version.ClientName, version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -544,8 +552,12 @@ $ %s query gov param deposit
 				var param types.DepositParams
 				cdc.MustUnmarshalJSON(res, &param)
 				out = param
+			case "proposal_type":
+				var param types.ProposalTypeParamsList
+				cdc.MustUnmarshalJSON(res, &param)
+				out = param
 			default:
-				return fmt.Errorf("Argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("Argument must be one of (voting|tallying|deposit|proposal_type), was %s", args[0])
 			}
 
 			return cliCtx.PrintOutput(out)
@@ -29,6 +29,7 @@ const (
 	flagStatus       = "status"
 	flagNumLimit     = "limit"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -36,6 +37,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -70,6 +72,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(client.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdCancelProposal(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -100,6 +104,11 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+An expedited proposal has a shorter voting period but requires a higher deposit
+and threshold. It is submitted with the --expedited flag or by setting
+"expedited" to true in the proposal file. If it fails to meet the expedited
+threshold, it is converted to a regular proposal.
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -121,6 +130,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.IsExpedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -129,9 +139,10 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 		},
 	}
 
+	cmd.Flags().Bool(FlagExpedited, false, "submit an expedited proposal")
 	cmd.Flags().String(FlagTitle, "", "title of proposal")
 	cmd.Flags().String(FlagDescription, "", "description of proposal")
-	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
+	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
@@ -232,4 +243,163 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdCancelProposal implements the command to cancel a proposal.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in its deposit or voting period. Only the proposer
+can cancel a proposal. Its votes are deleted and a fraction of its deposits, set
+by the proposal cancel ratio deposit parameter, is burned while the remainder is
+refunded.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(cliCtx.GetFromAddress(), proposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal splitting the voting power across options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal splitting the voting power across
+several options. The weights must be positive and sum to 1. You can find the
+proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which weighted options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
+
+// GetCmdSubmitExecProposal implements the command to submit a proposal
+// executing messages on behalf of the governance module account.
+func GetCmdSubmitExecProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages on behalf of the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing messages on behalf of the governance module
+account along with an initial deposit. The proposal details must be supplied via
+a JSON file. Every message must be signed by the governance module account only
+and the messages are executed atomically once the proposal passes.
+
+Example:
+$ %s tx gov submit-proposal exec <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Fund Account",
+  "description": "Send coins held by the governance module account",
+  "msgs": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "<governance_module_address>",
+        "to_address": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+        "amount": [
+          {
+            "denom": "stake",
+            "amount": "10000"
+          }
+        ]
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "is_expedited": false
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := govutils.ParseExecProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExecProposal(proposal.Title, proposal.Description, proposal.Msgs)
+
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			msg.IsExpedited = proposal.IsExpedited
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hyperspeednetwork/hsnhub/client/context"
 	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/gov/client/cli"
 	"github.com/hyperspeednetwork/hsnhub/x/gov/client/rest"
 )
 
@@ -20,6 +21,10 @@ type ProposalHandler struct {
 	RESTHandler RESTHandlerFn
 }
 
+// ExecProposalHandler is the proposal handler for proposals executing messages
+// on behalf of the governance module account
+var ExecProposalHandler = NewProposalHandler(cli.GetCmdSubmitExecProposal, rest.ExecProposalRESTHandler)
+
 // NewProposalHandler creates a new ProposalHandler object
 func NewProposalHandler(cliHandler CLIHandlerFn, restHandler RESTHandlerFn) ProposalHandler {
 	return ProposalHandler{
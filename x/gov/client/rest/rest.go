@@ -39,9 +39,21 @@ type PostProposalReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
 	Title          string         `json:"title" yaml:"title"`                     // Title of the proposal
 	Description    string         `json:"description" yaml:"description"`         // Description of the proposal
-	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal}
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	IsExpedited    bool           `json:"is_expedited" yaml:"is_expedited"`       // Whether the proposal is expedited
+}
+
+// ExecProposalReq defines the properties of an exec proposal request's body.
+type ExecProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`               // Title of the proposal
+	Description string         `json:"description" yaml:"description"`   // Description of the proposal
+	Msgs        []sdk.Msg      `json:"msgs" yaml:"msgs"`                 // Messages executed by the governance module account
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`         // Address of the proposer
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`           // Coins to add to the proposal's deposit
+	IsExpedited bool           `json:"is_expedited" yaml:"is_expedited"` // Whether the proposal is expedited
 }
 
 // DepositReq defines the properties of a deposit request's body.
@@ -57,3 +69,16 @@ type VoteReq struct {
 	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`   // address of the voter
 	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
 }
+
+// CancelProposalReq defines the properties of a cancel proposal request's body.
+type CancelProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"` // address of the proposer
+}
+
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`     // address of the voter
+	Options string         `json:"options" yaml:"options"` // weighted options chosen by the voter, e.g. "yes=0.6,no=0.4"
+}
@@ -1,6 +1,39 @@
 package utils
 
-import "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+// ExecProposalJSON defines an ExecProposal with a deposit used to parse exec
+// proposals from a JSON file.
+type ExecProposalJSON struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	IsExpedited bool      `json:"is_expedited" yaml:"is_expedited"`
+}
+
+// ParseExecProposalJSON reads and parses an ExecProposalJSON from a file.
+func ParseExecProposalJSON(cdc *codec.Codec, proposalFile string) (ExecProposalJSON, error) {
+	proposal := ExecProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,15 +55,24 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified weighted vote
+// options, e.g. "yes=0.6,no=0.4"
+func NormalizeWeightedVoteOptions(options string) string {
+	pairs := strings.Split(options, ",")
+	for i, pair := range pairs {
+		fields := strings.Split(pair, "=")
+		fields[0] = NormalizeVoteOption(strings.TrimSpace(fields[0]))
+		pairs[i] = strings.Join(fields, "=")
+	}
+	return strings.Join(pairs, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
 	case "Text", "text":
 		return types.ProposalTypeText
 
-	case "SoftwareUpgrade", "software_upgrade":
-		return types.ProposalTypeSoftwareUpgrade
-
 	default:
 		return ""
 	}
@@ -47,6 +89,8 @@ func NormalizeProposalStatus(status string) string {
 		return "Passed"
 	case "Rejected", "rejected":
 		return "Rejected"
+	case "Canceled", "canceled":
+		return "Canceled"
 	}
 	return ""
 }
@@ -22,6 +22,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case MsgCancelProposal:
+			return handleMsgCancelProposal(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -30,11 +36,20 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
-	proposal, err := keeper.SubmitProposal(ctx, msg.Content)
+	submitProposal := keeper.SubmitProposal
+	if msg.IsExpedited {
+		submitProposal = keeper.SubmitExpeditedProposal
+	}
+
+	proposal, err := submitProposal(ctx, msg.Content)
 	if err != nil {
 		return err.Result()
 	}
 
+	// record the proposer, who is allowed to cancel the proposal
+	proposal.Proposer = msg.Proposer
+	keeper.SetProposal(ctx, proposal)
+
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, msg.Proposer, msg.InitialDeposit)
 	if err != nil {
 		return err.Result()
@@ -106,3 +121,37 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	return sdk.Result{Events: ctx.EventManager().Events()}
 
 }
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCancelProposal(ctx sdk.Context, keeper Keeper, msg MsgCancelProposal) sdk.Result {
+	err := keeper.CancelProposal(ctx, msg.ProposalID, msg.Proposer)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -19,3 +19,35 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized gov message type"))
 }
+
+func TestHandleMsgCancelProposal(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+	handler := NewHandler(input.keeper)
+
+	newProposalMsg := NewMsgSubmitProposal(
+		ContentFromProposalType("test", "test", ProposalTypeText),
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
+		input.addrs[0],
+	)
+	res := handler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	proposalID := GetProposalIDFromBytes(res.Data)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, input.addrs[0], proposal.Proposer)
+
+	res = handler(ctx, NewMsgCancelProposal(input.addrs[1], proposalID))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, NewMsgCancelProposal(input.addrs[0], proposalID))
+	require.True(t, res.IsOK())
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusCanceled, proposal.Status)
+}
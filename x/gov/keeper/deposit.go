@@ -59,6 +59,40 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// burnAndRefundDeposits burns the given fraction of each deposit on a specific
+// proposal, refunds the remainder and deletes the deposits
+func (keeper Keeper) burnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		var burnAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := coin.Amount.ToDec().Mul(burnRatio).TruncateInt()
+			if amount.IsPositive() {
+				burnAmount = append(burnAmount, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		refundAmount := deposit.Amount.Sub(burnAmount)
+
+		if !burnAmount.IsZero() {
+			err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		if !refundAmount.IsZero() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refundAmount)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+}
+
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -117,7 +151,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	depositParams, _ := keeper.GetProposalParams(ctx, proposal.Content)
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(depositParams.GetMinDeposit(proposal.IsExpedited)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
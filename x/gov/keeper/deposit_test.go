@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -96,3 +97,24 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
 	require.Equal(t, addr1Initial, ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
 }
+
+func TestDepositsProposalTypeMinDeposit(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	// text proposals need twice the global minimum deposit
+	minDeposit := keeper.GetDepositParams(ctx).MinDeposit
+	keeper.SetProposalTypeParams(ctx, types.ProposalTypeParamsList{
+		types.NewProposalTypeParams(types.ProposalTypeText, minDeposit.Add(minDeposit), sdk.Dec{}, sdk.Dec{}),
+	})
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	err, votingStarted := keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	err, votingStarted = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[1], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+}
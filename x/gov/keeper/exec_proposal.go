@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+// HandleProposalContent executes the content of a passed proposal. The messages
+// of an ExecProposal are dispatched through the message router while any other
+// content is processed by the handler registered for its proposal route.
+func (keeper Keeper) HandleProposalContent(ctx sdk.Context, content types.Content) sdk.Error {
+	if exec, ok := content.(types.ExecProposal); ok {
+		return keeper.ExecuteProposalMsgs(ctx, exec.Msgs)
+	}
+
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	return handler(ctx, content)
+}
+
+// ValidateProposalMsgs checks that every message has the governance module
+// account as its only signer and can be routed by the message router.
+func (keeper Keeper) ValidateProposalMsgs(msgs []sdk.Msg) sdk.Error {
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	for _, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.ErrInvalidProposalMsg(keeper.codespace,
+				fmt.Sprintf("message %s must be signed by the governance module account %s only", msg.Type(), govAddr))
+		}
+
+		if keeper.msgRouter.Route(msg.Route()) == nil {
+			return types.ErrInvalidProposalMsg(keeper.codespace, fmt.Sprintf("unrecognized message route: %s", msg.Route()))
+		}
+	}
+
+	return nil
+}
+
+// ExecuteProposalMsgs executes the messages on behalf of the governance module
+// account, stopping at the first failing message. A message whose handler
+// panics fails as well. The caller is responsible for discarding the state
+// changes of a failed execution.
+//
+// The governance module account holds the deposits of the proposals still in
+// progress, which the messages are not allowed to spend. Accounts are funded
+// from the community pool instead, with the distribution MsgCommunityPoolSpend.
+func (keeper Keeper) ExecuteProposalMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	if err := keeper.ValidateProposalMsgs(msgs); err != nil {
+		return err
+	}
+
+	for _, msg := range msgs {
+		if err := keeper.executeProposalMsg(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	deposits := sdk.NewCoins()
+	keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = deposits.Add(deposit.Amount)
+		return false
+	})
+
+	if !keeper.GetGovernanceAccount(ctx).GetCoins().IsAllGTE(deposits) {
+		return types.ErrInvalidProposalMsg(keeper.codespace, "messages cannot spend the proposal deposits")
+	}
+
+	return nil
+}
+
+// executeProposalMsg dispatches a single message to its handler, turning a
+// panic of the handler into an error as the proposals are executed in the
+// EndBlocker.
+func (keeper Keeper) executeProposalMsg(ctx sdk.Context, msg sdk.Msg) (err sdk.Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = types.ErrInvalidProposalMsg(keeper.codespace, fmt.Sprintf("message %s panicked: %v", msg.Type(), r))
+		}
+	}()
+
+	handler := keeper.msgRouter.Route(msg.Route())
+
+	res := handler(ctx, msg)
+	if !res.IsOK() {
+		return types.ErrInvalidProposalMsg(keeper.codespace, fmt.Sprintf("message %s failed: %s", msg.Type(), res.Log))
+	}
+
+	return nil
+}
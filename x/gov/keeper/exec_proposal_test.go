@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+	distr "github.com/hyperspeednetwork/hsnhub/x/distribution/types"
+	"github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+func TestSubmitExecProposal(t *testing.T) {
+	ctx, _, keeper, _, supplyKeeper := createTestInput(t, false, 100)
+	govAddr := supplyKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// valid proposal sending coins from the governance module account
+	msgs := []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[0], coins)}
+	_, err := keeper.SubmitProposal(ctx, types.NewExecProposal("title", "description", msgs))
+	require.NoError(t, err)
+
+	// messages must be signed by the governance module account
+	msgs = []sdk.Msg{bank.NewMsgSend(TestAddrs[0], TestAddrs[1], coins)}
+	_, err = keeper.SubmitProposal(ctx, types.NewExecProposal("title", "description", msgs))
+	require.Error(t, err)
+
+	// messages must be routable
+	msgs = []sdk.Msg{types.NewMsgDeposit(govAddr, 1, coins)}
+	_, err = keeper.SubmitProposal(ctx, types.NewExecProposal("title", "description", msgs))
+	require.Error(t, err)
+}
+
+func TestExecuteProposalMsgs(t *testing.T) {
+	ctx, ak, keeper, _, supplyKeeper := createTestInput(t, false, 100)
+	govAddr := supplyKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// fund the governance module account
+	require.NoError(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, TestAddrs[0], types.ModuleName, coins))
+
+	// the state changes of a failed execution are discarded by the caller
+	cacheCtx, _ := ctx.CacheContext()
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(govAddr, TestAddrs[1], coins),
+		bank.NewMsgSend(govAddr, TestAddrs[1], coins),
+	}
+	require.Error(t, keeper.ExecuteProposalMsgs(cacheCtx, msgs))
+
+	// deposits of the proposals in progress cannot be spent
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	err, _ = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], coins)
+	require.NoError(t, err)
+
+	cacheCtx, _ = ctx.CacheContext()
+	msgs = []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[1], coins.Add(coins))}
+	require.Error(t, keeper.ExecuteProposalMsgs(cacheCtx, msgs))
+
+	balance := ak.GetAccount(ctx, TestAddrs[1]).GetCoins()
+	msgs = []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[1], coins)}
+	require.NoError(t, keeper.ExecuteProposalMsgs(ctx, msgs))
+	require.Equal(t, balance.Add(coins), ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
+	require.Equal(t, coins, keeper.GetGovernanceAccount(ctx).GetCoins())
+}
+
+func TestExecuteProposalMsgsPanic(t *testing.T) {
+	ctx, _, keeper, _, supplyKeeper := createTestInput(t, false, 100)
+	govAddr := supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	keeper.msgRouter.AddRoute("TestMsg", func(sdk.Context, sdk.Msg) sdk.Result {
+		panic("handler panic")
+	})
+
+	// a panicking message fails the execution
+	msgs := []sdk.Msg{sdk.NewTestMsg(govAddr)}
+	err := keeper.ExecuteProposalMsgs(ctx, msgs)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidProposalMsg, err.Code())
+}
+
+func TestExecProposalParams(t *testing.T) {
+	ctx, _, keeper, _, supplyKeeper := createTestInput(t, false, 100)
+	govAddr := supplyKeeper.GetModuleAddress(types.ModuleName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	bigDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)))
+
+	keeper.SetProposalTypeParams(ctx, types.ProposalTypeParamsList{
+		types.NewProposalTypeParams(types.ProposalTypeExec, nil, sdk.NewDecWithPrec(4, 1), sdk.Dec{}),
+		types.NewProposalTypeParams(distr.ProposalTypeCommunityPoolSpend, bigDeposit, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(6, 1)),
+	})
+
+	// exec proposals use the params of the exec proposal type
+	content := types.NewExecProposal("title", "description", []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[0], coins)})
+	dp, tp := keeper.GetProposalParams(ctx, content)
+	require.Equal(t, keeper.GetDepositParams(ctx).MinDeposit, dp.MinDeposit)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), tp.Quorum)
+	require.Equal(t, keeper.GetTallyParams(ctx).Threshold, tp.Threshold)
+
+	// a wrapped community pool spend is held to the community pool spend params
+	content = types.NewExecProposal("title", "description", []sdk.Msg{
+		bank.NewMsgSend(govAddr, TestAddrs[0], coins),
+		distr.NewMsgCommunityPoolSpend(govAddr, TestAddrs[0], coins),
+	})
+	dp, tp = keeper.GetProposalParams(ctx, content)
+	require.Equal(t, bigDeposit, dp.MinDeposit)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), tp.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tp.Threshold)
+}
@@ -34,6 +34,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Message router used to dispatch the messages of passed ExecProposals
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -42,10 +45,13 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The message router is expected to be the application's message router.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, codespace sdk.CodespaceType, rtr types.Router,
+	msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -66,6 +72,7 @@ func NewKeeper(
 		cdc:          cdc,
 		codespace:    codespace,
 		router:       rtr,
+		msgRouter:    msgRouter,
 	}
 }
 
@@ -79,6 +86,11 @@ func (keeper Keeper) Router() types.Router {
 	return keeper.router
 }
 
+// MsgRouter returns the gov Keeper's message Router
+func (keeper Keeper) MsgRouter() sdk.Router {
+	return keeper.msgRouter
+}
+
 // GetGovernanceAccount returns the governance ModuleAccount
 func (keeper Keeper) GetGovernanceAccount(ctx sdk.Context) exported.ModuleAccountI {
 	return keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
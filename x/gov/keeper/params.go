@@ -26,6 +26,28 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetProposalTypeParams returns the current per proposal type params from the
+// global param store
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context) types.ProposalTypeParamsList {
+	var proposalTypeParams types.ProposalTypeParamsList
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProposalTypeParams, &proposalTypeParams)
+	return proposalTypeParams
+}
+
+// GetProposalParams returns the DepositParams and TallyParams applying to a
+// proposal content, i.e. the global ones overridden by the params of the content
+// proposal type and subtypes
+func (keeper Keeper) GetProposalParams(ctx sdk.Context, content types.Content) (types.DepositParams, types.TallyParams) {
+	var subtypes []string
+	if c, ok := content.(types.SubtypedContent); ok {
+		subtypes = c.ProposalSubtypes()
+	}
+
+	return keeper.GetProposalTypeParams(ctx).EffectiveParams(
+		keeper.GetDepositParams(ctx), keeper.GetTallyParams(ctx), content.ProposalType(), subtypes,
+	)
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +62,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetProposalTypeParams sets the per proposal type params to the global param store
+func (keeper Keeper) SetProposalTypeParams(ctx sdk.Context, proposalTypeParams types.ProposalTypeParamsList) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyProposalTypeParams, &proposalTypeParams)
+}
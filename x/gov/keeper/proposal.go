@@ -9,17 +9,36 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, false)
+}
+
+// SubmitExpeditedProposal create new expedited proposal given a content. An
+// expedited proposal requires a higher deposit and threshold but has a shorter
+// voting period.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content types.Content) (types.Proposal, sdk.Error) {
+	return keeper.submitProposal(ctx, content, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content types.Content, expedited bool) (types.Proposal, sdk.Error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, types.ErrNoProposalHandlerExists(keeper.codespace, content)
 	}
 
-	// Execute the proposal content in a cache-wrapped context to validate the
-	// actual parameter changes before the proposal proceeds through the
-	// governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, types.ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+	if exec, ok := content.(types.ExecProposal); ok {
+		// The outcome of the messages depends on the state at execution time,
+		// so only their routes and signers are checked on submission.
+		if err := keeper.ValidateProposalMsgs(exec.Msgs); err != nil {
+			return types.Proposal{}, err
+		}
+	} else {
+		// Execute the proposal content in a cache-wrapped context to validate the
+		// actual parameter changes before the proposal proceeds through the
+		// governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, types.ErrInvalidProposalContent(keeper.codespace, err.Result().Log)
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -31,6 +50,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.IsExpedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -46,6 +66,55 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal in its deposit or voting period on behalf of
+// its proposer. The proposal is removed from its queue and its votes are
+// deleted. The ProposalCancelRatio fraction of its deposits is burned and the
+// remainder is refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) sdk.Error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return types.ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+
+	if proposal.Proposer.Empty() || !proposal.Proposer.Equals(proposer) {
+		return types.ErrInvalidProposer(keeper.codespace, proposalID, proposer)
+	}
+
+	cancelRatio := keeper.GetDepositParams(ctx).GetProposalCancelRatio()
+	if cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return types.ErrInvalidCancelRatio(keeper.codespace, cancelRatio)
+	}
+
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
+	case types.StatusVotingPeriod:
+		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	default:
+		return types.ErrAlreadyFinishedProposal(keeper.codespace, proposalID)
+	}
+
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		keeper.deleteVote(ctx, proposalID, vote.Voter)
+		return false
+	})
+
+	keeper.burnAndRefundDeposits(ctx, proposalID, cancelRatio)
+
+	proposal.Status = types.StatusCanceled
+	keeper.SetProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalCanceled),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -167,7 +236,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.IsExpedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -120,3 +120,116 @@ func TestSubmitProposal(t *testing.T) {
 		require.Equal(t, tc.expectedErr, err, "unexpected type of error: %s", err)
 	}
 }
+
+func TestCancelProposal(t *testing.T) {
+	ctx, ak, keeper, _, _ := createTestInput(t, false, 100)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	tenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Proposer = TestAddrs[0]
+	keeper.SetProposal(ctx, proposal)
+
+	err, _ = keeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+	err, votingStarted := keeper.AddDeposit(ctx, proposalID, TestAddrs[1], tenStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNo))
+
+	// only the proposer can cancel the proposal
+	err = keeper.CancelProposal(ctx, proposalID, TestAddrs[1])
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidProposer, err.Code())
+
+	err = keeper.CancelProposal(ctx, proposalID+1, TestAddrs[0])
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownProposal, err.Code())
+
+	addr0Initial := ak.GetAccount(ctx, TestAddrs[0]).GetCoins()
+	addr1Initial := ak.GetAccount(ctx, TestAddrs[1]).GetCoins()
+
+	require.NoError(t, keeper.CancelProposal(ctx, proposalID, TestAddrs[0]))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusCanceled, proposal.Status)
+	require.Empty(t, keeper.GetVotes(ctx, proposalID))
+	require.Empty(t, keeper.GetDeposits(ctx, proposalID))
+
+	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeIterator.Valid())
+	activeIterator.Close()
+
+	// half of the deposits are burned with the default cancel ratio
+	twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2)))
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	require.Equal(t, addr0Initial.Add(twoStake), ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
+	require.Equal(t, addr1Initial.Add(fiveStake), ak.GetAccount(ctx, TestAddrs[1]).GetCoins())
+
+	// a finished proposal cannot be canceled
+	err = keeper.CancelProposal(ctx, proposalID, TestAddrs[0])
+	require.Error(t, err)
+	require.Equal(t, types.CodeAlreadyFinishedProposal, err.Code())
+}
+
+func TestCancelProposalDepositPeriod(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposal.Proposer = TestAddrs[0]
+	keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, keeper.CancelProposal(ctx, proposal.ProposalID, TestAddrs[0]))
+
+	inactiveIterator := keeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
+	require.False(t, inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusCanceled, proposal.Status)
+}
+
+func TestCancelProposalCancelRatio(t *testing.T) {
+	ctx, ak, keeper, _, _ := createTestInput(t, false, 100)
+
+	tenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+
+	submit := func() uint64 {
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Proposer = TestAddrs[0]
+		keeper.SetProposal(ctx, proposal)
+		err, _ = keeper.AddDeposit(ctx, proposal.ProposalID, TestAddrs[0], tenStake)
+		require.NoError(t, err)
+		return proposal.ProposalID
+	}
+
+	// a ratio above one is rejected before any deposit is touched
+	depositParams := keeper.GetDepositParams(ctx)
+	depositParams.ProposalCancelRatio = sdk.NewDecWithPrec(15, 1)
+	keeper.SetDepositParams(ctx, depositParams)
+
+	proposalID := submit()
+	err := keeper.CancelProposal(ctx, proposalID, TestAddrs[0])
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidCancelRatio, err.Code())
+	require.Len(t, keeper.GetDeposits(ctx, proposalID), 1)
+
+	// params stored without a ratio fall back to the default one
+	depositParams.ProposalCancelRatio = sdk.Dec{}
+	keeper.SetDepositParams(ctx, depositParams)
+	require.True(t, keeper.GetDepositParams(ctx).ProposalCancelRatio.IsNil())
+
+	addr0Initial := ak.GetAccount(ctx, TestAddrs[0]).GetCoins()
+	require.NoError(t, keeper.CancelProposal(ctx, proposalID, TestAddrs[0]))
+
+	refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom,
+		sdk.TokensFromConsensusPower(10).ToDec().Mul(sdk.OneDec().Sub(types.DefaultProposalCancelRatio)).TruncateInt()))
+	require.Equal(t, addr0Initial.Add(refund), ak.GetAccount(ctx, TestAddrs[0]).GetCoins())
+}
@@ -56,6 +56,14 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamProposalType:
+		// return the effective params of each proposal type rather than the overrides
+		proposalTypeParams := keeper.GetProposalTypeParams(ctx).Effective(keeper.GetDepositParams(ctx), keeper.GetTallyParams(ctx))
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, proposalTypeParams)
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -149,7 +157,8 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	if proposal.Status == types.StatusDepositPeriod {
 		tallyResult = types.EmptyTallyResult()
-	} else if proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected {
+	} else if proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusCanceled {
 		tallyResult = proposal.FinalTallyResult
 	} else {
 		// proposal is in voting period
@@ -27,7 +27,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			types.WeightedVoteOptions{},
 		)
 
 		return false
@@ -38,7 +38,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		// if delegator tally voting power
 		valAddrStr := sdk.ValAddress(vote.Voter).String()
 		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.Options
 			currValidators[valAddrStr] = val
 		} else {
 			// iterate over all delegations from voter, deduct from any delegated-to validators
@@ -52,7 +52,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 					delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 					votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
+					// split the voting power across the weighted options
+					for _, option := range vote.Options {
+						subPower := votingPower.Mul(option.Weight)
+						results[option.Option] = results[option.Option].Add(subPower)
+					}
 					totalVotingPower = totalVotingPower.Add(votingPower)
 				}
 
@@ -66,7 +70,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
+		if len(val.Vote) == 0 {
 			continue
 		}
 
@@ -74,11 +78,14 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	_, tallyParams := keeper.GetProposalParams(ctx, proposal.Content)
 	tallyResults = types.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -104,7 +111,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// Expedited proposals must meet a higher threshold
+	threshold := tallyParams.GetThreshold(proposal.IsExpedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
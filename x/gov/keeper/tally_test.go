@@ -396,3 +396,84 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.False(t, burnDeposits)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
+
+func TestTallyWeightedVotes(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val1, found := sk.GetValidator(ctx, valOpAddr1)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	delegatorOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	validatorOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(4, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(6, 1)),
+	}
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr3, validatorOptions))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], delegatorOptions))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	// yes: 5 (val1 after deduction) + 15 (delegator) + 2.8 (val3), minus decimal truncation
+	// no: 15 (delegator) + 6 (val2) + 4.2 (val3)
+	require.True(t, sdk.NewInt(22800000).Sub(tallyResults.Yes).LTE(sdk.OneInt()))
+	require.Equal(t, sdk.NewInt(25200000), tallyResults.No)
+	require.True(t, tallyResults.Abstain.IsZero())
+	require.True(t, tallyResults.NoWithVeto.IsZero())
+}
+
+func TestTallyProposalTypeQuorum(t *testing.T) {
+	ctx, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 5, 5})
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionYes))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionYes))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	// tally in a cache context as votes are deleted while tallying
+	cacheCtx, _ := ctx.CacheContext()
+	passes, _, _ := keeper.Tally(cacheCtx, proposal)
+	require.True(t, passes)
+
+	// text proposals need three quarters of the voting power to vote
+	keeper.SetProposalTypeParams(ctx, types.ProposalTypeParamsList{
+		types.NewProposalTypeParams(types.ProposalTypeText, nil, sdk.NewDecWithPrec(75, 2), sdk.Dec{}),
+	})
+
+	passes, burnDeposits, _ := keeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+}
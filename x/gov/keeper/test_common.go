@@ -18,6 +18,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/hyperspeednetwork/hsnhub/baseapp"
 	"github.com/hyperspeednetwork/hsnhub/codec"
 	"github.com/hyperspeednetwork/hsnhub/store"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
@@ -79,6 +80,7 @@ func newPubKey(pk string) (res crypto.PubKey) {
 func makeTestCodec() *codec.Codec {
 	var cdc = codec.New()
 	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
 	types.RegisterCodec(cdc)
 	supply.RegisterCodec(cdc)
 	staking.RegisterCodec(cdc)
@@ -148,12 +150,16 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 
 	sk := staking.NewKeeper(cdc, keyStaking, tkeyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	sk.SetParams(ctx, staking.DefaultParams())
+	bankKeeper.SetSendEnabled(ctx, true)
 
 	rtr := types.NewRouter().
 		AddRoute(types.RouterKey, types.ProposalHandler)
 
+	msgRtr := baseapp.NewRouter().
+		AddRoute(bank.RouterKey, bank.NewHandler(bankKeeper))
+
 	keeper := NewKeeper(cdc, keyGov, pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()),
-	supplyKeeper, sk, types.DefaultCodespace, rtr)
+	supplyKeeper, sk, types.DefaultCodespace, rtr, msgRtr)
 
 	keeper.SetProposalID(ctx, types.DefaultStartingProposalID)
 	keeper.SetDepositParams(ctx, types.DefaultDepositParams())
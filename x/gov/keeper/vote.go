@@ -9,6 +9,18 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) sdk.Error {
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(keeper.codespace, option)
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote splitting the voting power of the voter across
+// several options on a specific proposal
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress,
+	options types.WeightedVoteOptions) sdk.Error {
+
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return types.ErrUnknownProposal(keeper.codespace, proposalID)
@@ -17,17 +29,17 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return types.ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(keeper.codespace, option)
+	if !types.ValidWeightedVoteOptions(options) {
+		return types.ErrInvalidWeightedVote(keeper.codespace, options)
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -62,13 +74,14 @@ func (keeper Keeper) GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	}
 
 	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
-	return vote, true
+	return vote.MigrateLegacyOption(), true
 }
 
-// SetVote sets a Vote to the gov store
+// SetVote sets a Vote to the gov store, converting the deprecated Option of a
+// legacy vote to Options
 func (keeper Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(vote)
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(vote.MigrateLegacyOption())
 	store.Set(types.VoteKey(vote.ProposalID, vote.Voter), bz)
 }
 
@@ -82,7 +95,7 @@ func (keeper Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
 
-		if cb(vote) {
+		if cb(vote.MigrateLegacyOption()) {
 			break
 		}
 	}
@@ -98,7 +111,7 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 		var vote types.Vote
 		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
 
-		if cb(vote) {
+		if cb(vote.MigrateLegacyOption()) {
 			break
 		}
 	}
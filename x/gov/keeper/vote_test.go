@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/x/gov/types"
 )
 
@@ -33,7 +34,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionAbstain, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionAbstain)))
 
 	// Test change of vote
 	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes))
@@ -41,7 +42,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionYes, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionYes)))
 
 	// Test second vote
 	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNoWithVeto))
@@ -49,7 +50,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, TestAddrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
@@ -58,8 +59,58 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes, keeper.GetVotes(ctx, proposalID))
 	require.Equal(t, TestAddrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
-	require.Equal(t, types.OptionYes, votes[0].Option)
+	require.True(t, votes[0].Options.Equals(types.NewNonSplitVoteOption(types.OptionYes)))
 	require.Equal(t, TestAddrs[1], votes[1].Voter)
 	require.Equal(t, proposalID, votes[1].ProposalID)
-	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
+	require.True(t, votes[1].Options.Equals(types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+
+	// Test weighted vote
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[2], invalidOptions), "weights do not sum to one")
+
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[2], weightedOptions))
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[2])
+	require.True(t, found)
+	require.True(t, vote.Options.Equals(weightedOptions))
+}
+
+func TestLegacyVotes(t *testing.T) {
+	ctx, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// store a vote encoded before weighted votes were introduced
+	legacyVote := struct {
+		ProposalID uint64
+		Voter      sdk.AccAddress
+		Option     types.VoteOption
+	}{proposalID, TestAddrs[0], types.OptionNo}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteKey(proposalID, TestAddrs[0]), keeper.cdc.MustMarshalBinaryLengthPrefixed(legacyVote))
+
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionNo)))
+
+	votes := keeper.GetVotes(ctx, proposalID)
+	require.Len(t, votes, 1)
+	require.Equal(t, vote, votes[0])
+
+	// a legacy vote set from genesis is stored with its options
+	keeper.SetVote(ctx, types.Vote{ProposalID: proposalID, Voter: TestAddrs[1], Option: types.OptionYes})
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[1])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, vote.Options.Equals(types.NewNonSplitVoteOption(types.OptionYes)))
 }
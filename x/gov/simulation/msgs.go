@@ -8,6 +8,7 @@ import (
 
 	"github.com/hyperspeednetwork/hsnhub/baseapp"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
 	"github.com/hyperspeednetwork/hsnhub/x/gov"
 	"github.com/hyperspeednetwork/hsnhub/x/simulation"
 )
@@ -86,7 +87,11 @@ func SimulateSubmittingVotingAndSlashingForProposal(k gov.Keeper, contentSim Con
 		fops := make([]simulation.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
 			whenVote := ctx.BlockHeader().Time.Add(time.Duration(r.Int63n(int64(votingPeriod.Seconds()))) * time.Second)
-			fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)}
+			if r.Intn(2) == 0 {
+				fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: operationSimulateMsgVote(k, accs[whoVotes[i]], proposalID)}
+			} else {
+				fops[i] = simulation.FutureOperation{BlockTime: whenVote, Op: operationSimulateMsgVoteWeighted(k, accs[whoVotes[i]], proposalID)}
+			}
 		}
 
 		// 3) Make an operation to ensure slashes were done correctly. (Really should be a future invariant)
@@ -114,8 +119,28 @@ func SimulateTextProposalContent(r *rand.Rand, _ *baseapp.BaseApp, _ sdk.Context
 	)
 }
 
+// SimulateExecProposalContent returns random exec proposal content sending a
+// few coins from the governance module account to a random account.
+func SimulateExecProposalContent(k gov.Keeper) ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		recipient := simulation.RandomAcc(r, accs)
+		govAddr := k.GetGovernanceAccount(ctx).GetAddress()
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(10))+1))
+
+		return gov.NewExecProposal(
+			simulation.RandStringOfLength(r, 140),
+			simulation.RandStringOfLength(r, 5000),
+			[]sdk.Msg{bank.NewMsgSend(govAddr, recipient.Address, coins)},
+		)
+	}
+}
+
 func simulationCreateMsgSubmitProposal(r *rand.Rand, c gov.Content, s simulation.Account) (msg gov.MsgSubmitProposal, err error) {
-	msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+	if r.Intn(4) == 0 {
+		msg = gov.NewMsgSubmitExpeditedProposal(c, randomDeposit(r), s.Address)
+	} else {
+		msg = gov.NewMsgSubmitProposal(c, randomDeposit(r), s.Address)
+	}
 	if msg.ValidateBasic() != nil {
 		err = fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 	}
@@ -148,6 +173,39 @@ func SimulateMsgDeposit(k gov.Keeper) simulation.Operation {
 	}
 }
 
+// SimulateMsgCancelProposal generates a MsgCancelProposal from the proposer of
+// a random proposal.
+func SimulateMsgCancelProposal(k gov.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		proposalID, ok := randomProposalID(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(gov.ModuleName), nil, nil
+		}
+
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok || proposal.Proposer.Empty() ||
+			(proposal.Status != gov.StatusDepositPeriod && proposal.Status != gov.StatusVotingPeriod) {
+			return simulation.NoOpMsg(gov.ModuleName), nil, nil
+		}
+
+		msg := gov.NewMsgCancelProposal(proposal.Proposer, proposalID)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(gov.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok = gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // SimulateMsgVote generates a MsgVote with random values.
 func SimulateMsgVote(k gov.Keeper) simulation.Operation {
 	return operationSimulateMsgVote(k, simulation.Account{}, 0)
@@ -187,6 +245,45 @@ func operationSimulateMsgVote(k gov.Keeper, acc simulation.Account, proposalID u
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(k gov.Keeper) simulation.Operation {
+	return operationSimulateMsgVoteWeighted(k, simulation.Account{}, 0)
+}
+
+// nolint: unparam
+func operationSimulateMsgVoteWeighted(k gov.Keeper, acc simulation.Account, proposalID uint64) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		if acc.Equals(simulation.Account{}) {
+			acc = simulation.RandomAcc(r, accs)
+		}
+
+		if proposalID < uint64(0) {
+			var ok bool
+			proposalID, ok = randomProposalID(r, k, ctx)
+			if !ok {
+				return simulation.NoOpMsg(gov.ModuleName), nil, nil
+			}
+		}
+		options := randomWeightedVotingOptions(r)
+
+		msg := gov.NewMsgVoteWeighted(acc.Address, proposalID, options)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(gov.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := gov.NewHandler(k)(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
 // Pick a random deposit
 func randomDeposit(r *rand.Rand) sdk.Coins {
 	// TODO Choose based on account balance and min deposit
@@ -220,3 +317,23 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weighted voting options, splitting the weight in whole
+// percentages across all four options and dropping the empty ones
+func randomWeightedVotingOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	w1 := r.Int63n(101)
+	w2 := r.Int63n(101 - w1)
+	w3 := r.Int63n(101 - w1 - w2)
+	w4 := 100 - w1 - w2 - w3
+
+	voteOptions := []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto}
+
+	var options gov.WeightedVoteOptions
+	for i, weight := range []int64{w1, w2, w3, w4} {
+		if weight == 0 {
+			continue
+		}
+		options = append(options, gov.NewWeightedVoteOption(voteOptions[i], sdk.NewDecWithPrec(weight, 2)))
+	}
+	return options
+}
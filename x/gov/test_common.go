@@ -81,7 +81,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	sk := staking.NewKeeper(mApp.Cdc, keyStaking, tKeyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 
 	keeper := keep.NewKeeper(mApp.Cdc, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()),
-	supplyKeeper, sk, types.DefaultCodespace, rtr)
+	supplyKeeper, sk, types.DefaultCodespace, rtr, mApp.Router())
 
 	mApp.Router().AddRoute(types.RouterKey, NewHandler(keeper))
 	mApp.QueryRouter().AddRoute(types.QuerierRoute, keep.NewQuerier(keeper))
@@ -193,7 +193,7 @@ const contextKeyBadProposal = "contextKeyBadProposal"
 // for the key contextKeyBadProposal or if the value is false.
 func badProposalHandler(ctx sdk.Context, c types.Content) sdk.Error {
 	switch c.ProposalType() {
-	case types.ProposalTypeText, types.ProposalTypeSoftwareUpgrade:
+	case types.ProposalTypeText:
 		v := ctx.Value(contextKeyBadProposal)
 
 		if v == nil || !v.(bool) {
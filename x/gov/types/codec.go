@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 // module codec
@@ -15,9 +16,11 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(MsgCancelProposal{}, "cosmos-sdk/MsgCancelProposal", nil)
 
 	cdc.RegisterConcrete(TextProposal{}, "cosmos-sdk/TextProposal", nil)
-	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecProposal{}, "cosmos-sdk/ExecProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -27,7 +30,17 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
 }
 
+// RegisterProposalMsgCodec registers an external message type defined in
+// another module for the internal ModuleCdc. Only registered messages can be
+// carried by an ExecProposal and correctly Amino encoded in MsgSubmitProposal.
+func RegisterProposalMsgCodec(o interface{}, name string) {
+	ModuleCdc.RegisterConcrete(o, name, nil)
+}
+
 // TODO determine a good place to seal this codec
 func init() {
 	RegisterCodec(ModuleCdc)
+
+	// the messages carried by an ExecProposal are encoded as sdk.Msg
+	ModuleCdc.RegisterInterface((*sdk.Msg)(nil), nil)
 }
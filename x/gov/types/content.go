@@ -26,6 +26,14 @@ type Content interface {
 	String() string
 }
 
+// SubtypedContent defines an interface that a proposal can implement to be
+// further classified within its type, e.g. parameter changes by the subspaces
+// they change. Per type governance params can be set for each subtype.
+type SubtypedContent interface {
+	Content
+	ProposalSubtypes() []string
+}
+
 // Handler defines a function that handles a proposal after it has passed the
 // governance process.
 type Handler func(ctx sdk.Context, content Content) sdk.Error
@@ -23,6 +23,9 @@ const (
 	CodeInvalidGenesis           sdk.CodeType = 9
 	CodeInvalidProposalStatus    sdk.CodeType = 10
 	CodeProposalHandlerNotExists sdk.CodeType = 11
+	CodeInvalidProposalMsg       sdk.CodeType = 12
+	CodeInvalidProposer          sdk.CodeType = 13
+	CodeInvalidCancelRatio       sdk.CodeType = 14
 )
 
 // ErrUnknownProposal error for unknown proposals
@@ -40,6 +43,21 @@ func ErrAlreadyActiveProposal(codespace sdk.CodespaceType, proposalID uint64) sd
 	return sdk.NewError(codespace, CodeAlreadyActiveProposal, fmt.Sprintf("proposal %d has been already active", proposalID))
 }
 
+// ErrAlreadyFinishedProposal error for proposals whose voting period has ended
+func ErrAlreadyFinishedProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyFinishedProposal, fmt.Sprintf("proposal %d has already finished", proposalID))
+}
+
+// ErrInvalidProposer error for an address which is not the proposer of a proposal
+func ErrInvalidProposer(codespace sdk.CodespaceType, proposalID uint64, address sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposer, fmt.Sprintf("%s is not the proposer of proposal %d", address, proposalID))
+}
+
+// ErrInvalidCancelRatio error for a proposal cancel ratio outside of [0, 1]
+func ErrInvalidCancelRatio(codespace sdk.CodespaceType, ratio sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCancelRatio, fmt.Sprintf("proposal cancel ratio should be between zero and one, is %s", ratio))
+}
+
 // ErrInvalidProposalContent error for invalid proposal title or description
 func ErrInvalidProposalContent(cs sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(cs, CodeInvalidContent, fmt.Sprintf("invalid proposal content: %s", msg))
@@ -55,6 +73,12 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+// ErrInvalidWeightedVote error for invalid weighted vote options
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' are not valid weighted voting options, "+
+		"the options must be distinct with positive weights summing to 1", options.String()))
+}
+
 // ErrInvalidGenesis error for an invalid governance GenesisState
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
@@ -64,3 +88,8 @@ func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, content interface{}) sdk.Error {
 	return sdk.NewError(codespace, CodeProposalHandlerNotExists, fmt.Sprintf("'%T' does not have a corresponding handler", content))
 }
+
+// ErrInvalidProposalMsg error for an invalid or failing message of an ExecProposal
+func ErrInvalidProposalMsg(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalMsg, fmt.Sprintf("invalid proposal message: %s", msg))
+}
@@ -7,14 +7,17 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueCategory         = "governance"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
+	AttributeKeyProposalResult              = "proposal_result"
+	AttributeKeyOption                      = "option"
+	AttributeKeyProposalID                  = "proposal_id"
+	AttributeKeyVotingPeriodStart           = "voting_period_start"
+	AttributeValueCategory                  = "governance"
+	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
+	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
+	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
+	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited threshold, converted to regular
+	AttributeValueProposalCanceled          = "proposal_canceled"           // canceled by the proposer
 )
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// ExecProposal defines a proposal that executes a list of messages on behalf of
+// the governance module account once it passes. The messages are dispatched
+// through the application's message router and are executed atomically: if one
+// of them fails, none of their state changes are persisted.
+type ExecProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+// NewExecProposal creates an exec proposal Content
+func NewExecProposal(title, description string, msgs []sdk.Msg) Content {
+	return ExecProposal{title, description, msgs}
+}
+
+// Implements SubtypedContent Interface
+var _ SubtypedContent = ExecProposal{}
+
+// msgProposalTypes maps the subtype of the messages exec proposals can execute
+// to the proposal type performing the same action
+var msgProposalTypes = map[string]string{}
+
+// RegisterMsgProposalType registers the proposal type performing the same
+// action as a message, whose params then also apply to the exec proposals
+// executing the message. It will panic if the message is already registered.
+func RegisterMsgProposalType(msg sdk.Msg, proposalType string) {
+	subtype := ExecMsgSubtype(msg)
+	if _, ok := msgProposalTypes[subtype]; ok {
+		panic(fmt.Sprintf("already registered proposal type for message: %s", subtype))
+	}
+
+	msgProposalTypes[subtype] = proposalType
+}
+
+// ExecMsgSubtype returns the subtype of the exec proposals executing a message,
+// its route followed by its type, e.g. "distribution/community_pool_spend"
+func ExecMsgSubtype(msg sdk.Msg) string {
+	return msg.Route() + "/" + msg.Type()
+}
+
+// GetTitle returns the proposal title
+func (ep ExecProposal) GetTitle() string { return ep.Title }
+
+// GetDescription returns the proposal description
+func (ep ExecProposal) GetDescription() string { return ep.Description }
+
+// ProposalRoute returns the proposal router key
+func (ep ExecProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Exec"
+func (ep ExecProposal) ProposalType() string { return ProposalTypeExec }
+
+// ProposalSubtypes returns the subtypes of the messages executed by the
+// proposal, which select the governance params applying to it.
+func (ep ExecProposal) ProposalSubtypes() []string {
+	var subtypes []string
+	seen := make(map[string]bool)
+	for _, msg := range ep.Msgs {
+		subtype := ExecMsgSubtype(msg)
+		if !seen[subtype] {
+			seen[subtype] = true
+			subtypes = append(subtypes, subtype)
+		}
+	}
+	return subtypes
+}
+
+// ValidateBasic validates the content's title and description of the proposal
+// along with each of its messages, which must have exactly one signer. Whether
+// that signer is the governance module account is checked by the keeper.
+func (ep ExecProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, ep); err != nil {
+		return err
+	}
+	if len(ep.Msgs) == 0 {
+		return ErrInvalidProposalMsg(DefaultCodespace, "no messages to execute")
+	}
+
+	for _, msg := range ep.Msgs {
+		if msg == nil {
+			return ErrInvalidProposalMsg(DefaultCodespace, "nil message")
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		if len(msg.GetSigners()) != 1 {
+			return ErrInvalidProposalMsg(DefaultCodespace, "messages must have exactly one signer")
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (ep ExecProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Exec Proposal:
+  Title:       %s
+  Description: %s
+  Msgs:
+`, ep.Title, ep.Description))
+
+	for _, msg := range ep.Msgs {
+		b.WriteString(fmt.Sprintf("    %s/%s\n", msg.Route(), msg.Type()))
+	}
+
+	return b.String()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
@@ -16,6 +17,8 @@ type GenesisState struct {
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+
+	ProposalTypeParams ProposalTypeParamsList `json:"proposal_type_params,omitempty" yaml:"proposal_type_params,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -58,6 +61,12 @@ func ValidateGenesis(data GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold)
+	}
+
 	veto := data.TallyParams.Veto
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("Governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -69,5 +78,55 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if !data.DepositParams.ExpeditedMinDeposit.IsValid() || !data.DepositParams.ExpeditedMinDeposit.IsAllGT(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("Governance expedited deposit amount must be a valid sdk.Coins amount greater than the deposit amount, is %s",
+			data.DepositParams.ExpeditedMinDeposit.String())
+	}
+
+	cancelRatio := data.DepositParams.ProposalCancelRatio
+	if cancelRatio.IsNil() || cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("Governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			cancelRatio)
+	}
+
+	votingPeriod := data.VotingParams.VotingPeriod
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= votingPeriod {
+		return fmt.Errorf("Governance expedited voting period should be positive and shorter than the voting period %s, is %s",
+			votingPeriod, expeditedVotingPeriod)
+	}
+
+	return validateProposalTypeParams(data.ProposalTypeParams)
+}
+
+// validateProposalTypeParams checks that the per proposal type params are
+// within valid ranges and that each proposal type is set at most once
+func validateProposalTypeParams(list ProposalTypeParamsList) error {
+	seen := make(map[string]bool)
+	for _, ptp := range list {
+		if len(strings.TrimSpace(ptp.ProposalType)) == 0 {
+			return fmt.Errorf("Governance proposal type params must have a proposal type")
+		}
+		if seen[ptp.ProposalType] {
+			return fmt.Errorf("Governance proposal type params are set twice for %s", ptp.ProposalType)
+		}
+		seen[ptp.ProposalType] = true
+
+		if !ptp.MinDeposit.IsValid() {
+			return fmt.Errorf("Governance deposit amount of %s must be a valid sdk.Coins amount, is %s",
+				ptp.ProposalType, ptp.MinDeposit.String())
+		}
+
+		if !ptp.Quorum.IsNil() && (ptp.Quorum.IsNegative() || ptp.Quorum.GT(sdk.OneDec())) {
+			return fmt.Errorf("Governance quorum of %s should be positive and less or equal to one, is %s",
+				ptp.ProposalType, ptp.Quorum.String())
+		}
+
+		if !ptp.Threshold.IsNil() && (ptp.Threshold.IsNegative() || ptp.Threshold.GT(sdk.OneDec())) {
+			return fmt.Errorf("Governance vote threshold of %s should be positive and less or equal to one, is %s",
+				ptp.ProposalType, ptp.Threshold.String())
+		}
+	}
+
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -19,4 +21,35 @@ func TestEqualProposalID(t *testing.T) {
 	state2.StartingProposalID = 1
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
-}
\ No newline at end of file
+}
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	state := DefaultGenesisState()
+	state.TallyParams.ExpeditedThreshold = state.TallyParams.Threshold
+	require.Error(t, ValidateGenesis(state))
+
+	state = DefaultGenesisState()
+	state.DepositParams.ExpeditedMinDeposit = state.DepositParams.MinDeposit
+	require.Error(t, ValidateGenesis(state))
+
+	state = DefaultGenesisState()
+	state.VotingParams.ExpeditedVotingPeriod = state.VotingParams.VotingPeriod
+	require.Error(t, ValidateGenesis(state))
+
+	state = DefaultGenesisState()
+	state.VotingParams.ExpeditedVotingPeriod = 0
+	require.Error(t, ValidateGenesis(state))
+}
+
+func TestValidateGenesisProposalCancelRatio(t *testing.T) {
+	state := DefaultGenesisState()
+	state.DepositParams.ProposalCancelRatio = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, ValidateGenesis(state))
+
+	state.DepositParams.ProposalCancelRatio = sdk.Dec{}
+	require.Error(t, ValidateGenesis(state))
+
+	state.DepositParams.ProposalCancelRatio = sdk.ZeroDec()
+	require.NoError(t, ValidateGenesis(state))
+}
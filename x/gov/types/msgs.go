@@ -10,22 +10,31 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
 
 // MsgSubmitProposal defines a message to create a governance proposal with a
 // given content and initial deposit
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`               //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                             //  Address of the proposer
+	IsExpedited    bool           `json:"is_expedited,omitempty" yaml:"is_expedited,omitempty"` //  Whether the proposal is expedited
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{content, initialDeposit, proposer, false}
+}
+
+// NewMsgSubmitExpeditedProposal creates a new MsgSubmitProposal instance for
+// an expedited proposal
+func NewMsgSubmitExpeditedProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
+	return MsgSubmitProposal{content, initialDeposit, proposer, true}
 }
 
 // Route implements Msg
@@ -39,12 +48,6 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if msg.Content == nil {
 		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
-	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
-		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
-	}
 	if msg.Proposer.Empty() {
 		return sdk.ErrInvalidAddress(msg.Proposer.String())
 	}
@@ -66,7 +69,8 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, msg.IsExpedited)
 }
 
 // GetSignBytes implements Msg
@@ -181,3 +185,99 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted defines a message to cast a vote splitting the voting power
+// across several options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options chosen by the voter
+}
+
+// NewMsgVoteWeighted creates a message to cast a weighted vote on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgCancelProposal defines a message to cancel a proposal before its voting
+// period ends
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer" yaml:"proposer"`       //  Address of the proposer
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Proposer.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf(`Cancel Proposal Message:
+  Proposal ID: %d
+  Proposer:    %s
+`, msg.ProposalID, msg.Proposer)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
@@ -36,7 +36,7 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", "SoftwareUpgrade", addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsMulti, true},
@@ -68,6 +68,27 @@ func TestMsgDepositGetSignBytes(t *testing.T) {
 	require.Equal(t, expected, string(res))
 }
 
+func TestMsgSubmitExecProposalGetSignBytes(t *testing.T) {
+	msgs := []sdk.Msg{NewMsgDeposit(addrs[0], 1, coinsPos)}
+	content := NewExecProposal("Test Proposal", "the purpose of this proposal is to test", msgs)
+	msg := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+
+	res := msg.GetSignBytes()
+	require.Contains(t, string(res), `"type":"cosmos-sdk/ExecProposal"`)
+	require.Contains(t, string(res), `"type":"cosmos-sdk/MsgDeposit"`)
+}
+
+func TestMsgSubmitExpeditedProposalGetSignBytes(t *testing.T) {
+	content := NewTextProposal("Test Proposal", "the purpose of this proposal is to test")
+
+	msg := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+	require.NotContains(t, string(msg.GetSignBytes()), "is_expedited")
+
+	msg = NewMsgSubmitExpeditedProposal(content, coinsPos, addrs[0])
+	require.NoError(t, msg.ValidateBasic())
+	require.Contains(t, string(msg.GetSignBytes()), `"is_expedited":true`)
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	tests := []struct {
@@ -117,3 +138,69 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVoteWeighted(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(VoteOption(0x13), sdk.OneDec())}, false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,No=0.4")
+	require.NoError(t, err)
+	require.True(t, options.Equals(WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}))
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=one")
+	require.Error(t, err)
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
@@ -10,15 +11,19 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -26,6 +31,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyProposalTypeParams = []byte("proposaltypeparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -34,20 +41,26 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyDepositParams, DepositParams{},
 		ParamStoreKeyVotingParams, VotingParams{},
 		ParamStoreKeyTallyParams, TallyParams{},
+		ParamStoreKeyProposalTypeParams, ProposalTypeParamsList{},
 	)
 }
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`                     //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`       //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	ExpeditedMinDeposit sdk.Coins     `json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
+	ProposalCancelRatio sdk.Dec       `json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio,omitempty"` //  Fraction of the deposits burned when a proposal is canceled. Initial value: 0.5
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	proposalCancelRatio sdk.Dec) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
+		ProposalCancelRatio: proposalCancelRatio,
 	}
 }
 
@@ -56,96 +69,300 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+		DefaultProposalCancelRatio,
 	)
 }
 
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:           %s
+  Max Deposit Period:    %s
+  Expedited Min Deposit: %s
+  Proposal Cancel Ratio: %s`, dp.MinDeposit, dp.MaxDepositPeriod, dp.ExpeditedMinDeposit, dp.GetProposalCancelRatio())
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit) && dp.GetProposalCancelRatio().Equal(dp2.GetProposalCancelRatio())
+}
+
+// GetProposalCancelRatio returns the fraction of the deposits burned when a
+// proposal is canceled. Deposit params stored before the ratio was introduced
+// have no ratio and fall back to the default one.
+func (dp DepositParams) GetProposalCancelRatio() sdk.Dec {
+	if dp.ProposalCancelRatio.IsNil() {
+		return DefaultProposalCancelRatio
+	}
+	return dp.ProposalCancelRatio
+}
+
+// GetMinDeposit returns the minimum deposit for a regular or an expedited
+// proposal to enter voting period
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, veto, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		Veto:               veto,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExpeditedThreshold)
 }
 
 // String implements stringer insterface
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Veto:                %s
+  Expedited Threshold: %s`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.ExpeditedThreshold)
+}
+
+// GetThreshold returns the minimum proportion of Yes votes for a regular or an
+// expedited proposal to pass
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`                     //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"` //  Length of the voting period of expedited proposals.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // String implements stringer interface
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
+}
+
+// GetVotingPeriod returns the length of the voting period of a regular or an
+// expedited proposal
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
+}
+
+// ProposalTypeParams defines the params overriding the global deposit and tally
+// params for the proposals of a type. The proposal type can be followed by a
+// subtype, e.g. "ParameterChange/staking" for the parameter changes of the
+// staking subspace. Unset params keep the value of the proposal type, or the
+// global value if the proposal type has none.
+type ProposalTypeParams struct {
+	ProposalType string    `json:"proposal_type" yaml:"proposal_type"`                 //  Proposal type, optionally followed by a subtype
+	MinDeposit   sdk.Coins `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"` //  Minimum deposit for a proposal to enter voting period.
+	Quorum       sdk.Dec   `json:"quorum,omitempty" yaml:"quorum,omitempty"`           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold    sdk.Dec   `json:"threshold,omitempty" yaml:"threshold,omitempty"`     //  Minimum proportion of Yes votes for proposal to pass.
+}
+
+// NewProposalTypeParams creates a new ProposalTypeParams object
+func NewProposalTypeParams(proposalType string, minDeposit sdk.Coins, quorum, threshold sdk.Dec) ProposalTypeParams {
+	return ProposalTypeParams{
+		ProposalType: proposalType,
+		MinDeposit:   minDeposit,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// String implements stringer interface
+func (ptp ProposalTypeParams) String() string {
+	return fmt.Sprintf(`Proposal Type Params (%s):
+  Min Deposit: %s
+  Quorum:      %s
+  Threshold:   %s`, ptp.ProposalType, ptp.MinDeposit, ptp.Quorum, ptp.Threshold)
+}
+
+// ProposalTypeParamsList is a collection of ProposalTypeParams
+type ProposalTypeParamsList []ProposalTypeParams
+
+// String implements stringer interface
+func (l ProposalTypeParamsList) String() string {
+	if len(l) == 0 {
+		return "Proposal Type Params: []"
+	}
+
+	out := make([]string, len(l))
+	for i, ptp := range l {
+		out[i] = ptp.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Get returns the params of the given proposal type, or of the given subtype
+// of the proposal type if the subtype is not empty
+func (l ProposalTypeParamsList) Get(proposalType, subtype string) (ProposalTypeParams, bool) {
+	if subtype != "" {
+		proposalType = proposalType + "/" + subtype
+	}
+
+	for _, ptp := range l {
+		if ptp.ProposalType == proposalType {
+			return ptp, true
+		}
+	}
+	return ProposalTypeParams{}, false
+}
+
+// apply overrides the given deposit and tally params with the params which are set
+func (ptp ProposalTypeParams) apply(dp DepositParams, tp TallyParams) (DepositParams, TallyParams) {
+	if !ptp.MinDeposit.Empty() {
+		dp.MinDeposit = ptp.MinDeposit
+	}
+	if !ptp.Quorum.IsNil() && !ptp.Quorum.IsZero() {
+		tp.Quorum = ptp.Quorum
+	}
+	if !ptp.Threshold.IsNil() && !ptp.Threshold.IsZero() {
+		tp.Threshold = ptp.Threshold
+	}
+	return dp, tp
+}
+
+// EffectiveParams returns the deposit and tally params applying to the proposals
+// of the given type and subtypes. The params of the proposal type override the
+// global ones and are themselves overridden by the params of the subtypes. When
+// several subtypes set a param, the strictest value applies. The subtypes of
+// exec proposals are also held to the params of the proposal type registered
+// for their message, e.g. a community pool spend message to the params of the
+// community pool spend proposals. Expedited proposals never require less than
+// regular ones.
+func (l ProposalTypeParamsList) EffectiveParams(dp DepositParams, tp TallyParams,
+	proposalType string, subtypes []string) (DepositParams, TallyParams) {
+
+	if ptp, ok := l.Get(proposalType, ""); ok {
+		dp, tp = ptp.apply(dp, tp)
+	}
+
+	var strictest ProposalTypeParams
+	for _, subtype := range subtypes {
+		if ptp, ok := l.Get(proposalType, subtype); ok {
+			strictest = strictest.stricter(ptp)
+		}
+
+		if proposalType != ProposalTypeExec {
+			continue
+		}
+		if msgProposalType, ok := msgProposalTypes[subtype]; ok {
+			if ptp, ok := l.Get(msgProposalType, ""); ok {
+				strictest = strictest.stricter(ptp)
+			}
+		}
+	}
+	dp, tp = strictest.apply(dp, tp)
+
+	dp.ExpeditedMinDeposit = maxCoins(dp.ExpeditedMinDeposit, dp.MinDeposit)
+	if tp.ExpeditedThreshold.IsNil() || tp.ExpeditedThreshold.LT(tp.Threshold) {
+		tp.ExpeditedThreshold = tp.Threshold
+	}
+	return dp, tp
+}
+
+// stricter returns the params set by either of the given params, keeping the
+// strictest value of the params set by both
+func (ptp ProposalTypeParams) stricter(other ProposalTypeParams) ProposalTypeParams {
+	ptp.MinDeposit = maxCoins(ptp.MinDeposit, other.MinDeposit)
+	if !other.Quorum.IsNil() && (ptp.Quorum.IsNil() || other.Quorum.GT(ptp.Quorum)) {
+		ptp.Quorum = other.Quorum
+	}
+	if !other.Threshold.IsNil() && (ptp.Threshold.IsNil() || other.Threshold.GT(ptp.Threshold)) {
+		ptp.Threshold = other.Threshold
+	}
+	return ptp
+}
+
+// Effective returns the effective deposit and tally params of each proposal type
+// and subtype of the list, given the global deposit and tally params
+func (l ProposalTypeParamsList) Effective(dp DepositParams, tp TallyParams) ProposalTypeParamsList {
+	effective := make(ProposalTypeParamsList, len(l))
+	for i, ptp := range l {
+		var subtypes []string
+		proposalType := ptp.ProposalType
+		if idx := strings.Index(proposalType, "/"); idx >= 0 {
+			proposalType, subtypes = proposalType[:idx], []string{proposalType[idx+1:]}
+		}
+
+		edp, etp := l.EffectiveParams(dp, tp, proposalType, subtypes)
+		effective[i] = NewProposalTypeParams(ptp.ProposalType, edp.MinDeposit, etp.Quorum, etp.Threshold)
+	}
+	return effective
+}
+
+// maxCoins returns the greatest amount of each denomination of the given coins
+func maxCoins(coinsA, coinsB sdk.Coins) sdk.Coins {
+	var max sdk.Coins
+	for _, coin := range coinsA.Add(coinsB) {
+		max = append(max, sdk.NewCoin(coin.Denom, sdk.MaxInt(coinsA.AmountOf(coin.Denom), coinsB.AmountOf(coin.Denom))))
+	}
+	return max
 }
 
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+	VotingParams       VotingParams           `json:"voting_params" yaml:"voting_params"`
+	TallyParams        TallyParams            `json:"tally_params" yaml:"tally_params"`
+	DepositParams      DepositParams          `json:"deposit_params" yaml:"deposit_parmas"`
+	ProposalTypeParams ProposalTypeParamsList `json:"proposal_type_params" yaml:"proposal_type_params"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ProposalTypeParams.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, ptp ProposalTypeParamsList) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:       vp,
+		DepositParams:      dp,
+		TallyParams:        tp,
+		ProposalTypeParams: ptp,
 	}
 }
 
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), nil)
 }
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestProposalTypeParamsEffectiveParams(t *testing.T) {
+	dp, tp := DefaultDepositParams(), DefaultTallyParams()
+	bigDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)))
+
+	list := ProposalTypeParamsList{
+		NewProposalTypeParams("ParameterChange", nil, sdk.NewDecWithPrec(4, 1), sdk.Dec{}),
+		NewProposalTypeParams("ParameterChange/staking", bigDeposit, sdk.Dec{}, sdk.NewDecWithPrec(6, 1)),
+		NewProposalTypeParams("ParameterChange/slashing", nil, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(7, 1)),
+	}
+
+	// proposal types without params use the global params
+	edp, etp := list.EffectiveParams(dp, tp, ProposalTypeText, nil)
+	require.True(t, edp.Equal(dp))
+	require.Equal(t, tp, etp)
+
+	// the params of the proposal type override the global ones
+	edp, etp = list.EffectiveParams(dp, tp, "ParameterChange", []string{"bank"})
+	require.True(t, edp.Equal(dp))
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), etp.Quorum)
+	require.Equal(t, tp.Threshold, etp.Threshold)
+
+	// the params of a subtype override the ones of the proposal type
+	edp, etp = list.EffectiveParams(dp, tp, "ParameterChange", []string{"staking"})
+	require.Equal(t, bigDeposit, edp.MinDeposit)
+	require.Equal(t, bigDeposit, edp.ExpeditedMinDeposit)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), etp.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), etp.Threshold)
+	require.Equal(t, tp.ExpeditedThreshold, etp.ExpeditedThreshold)
+
+	// the strictest params of several subtypes apply
+	_, etp = list.EffectiveParams(dp, tp, "ParameterChange", []string{"staking", "slashing"})
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), etp.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), etp.Threshold)
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), etp.ExpeditedThreshold)
+
+	// exec proposals have the messages they execute as subtypes
+	list = append(list, NewProposalTypeParams(ProposalTypeExec+"/bank/send", nil, sdk.NewDecWithPrec(45, 2), sdk.Dec{}))
+	_, etp = list.EffectiveParams(dp, tp, ProposalTypeExec, []string{"bank/send"})
+	require.Equal(t, sdk.NewDecWithPrec(45, 2), etp.Quorum)
+	require.Equal(t, tp.Threshold, etp.Threshold)
+
+	effective := list.Effective(dp, tp)
+	require.Len(t, effective, 4)
+	require.Equal(t, "ParameterChange/staking", effective[1].ProposalType)
+	require.Equal(t, bigDeposit, effective[1].MinDeposit)
+	require.Equal(t, sdk.NewDecWithPrec(4, 1), effective[1].Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), effective[1].Threshold)
+}
+
+func TestValidateGenesisProposalTypeParams(t *testing.T) {
+	state := DefaultGenesisState()
+	state.ProposalTypeParams = ProposalTypeParamsList{
+		NewProposalTypeParams(ProposalTypeText, nil, sdk.NewDecWithPrec(4, 1), sdk.Dec{}),
+	}
+	require.NoError(t, ValidateGenesis(state))
+
+	state.ProposalTypeParams = append(state.ProposalTypeParams, state.ProposalTypeParams[0])
+	require.Error(t, ValidateGenesis(state))
+
+	state.ProposalTypeParams = ProposalTypeParamsList{
+		NewProposalTypeParams("", nil, sdk.Dec{}, sdk.Dec{}),
+	}
+	require.Error(t, ValidateGenesis(state))
+
+	state.ProposalTypeParams = ProposalTypeParamsList{
+		NewProposalTypeParams(ProposalTypeText, nil, sdk.Dec{}, sdk.NewDecWithPrec(11, 1)),
+	}
+	require.Error(t, ValidateGenesis(state))
+}
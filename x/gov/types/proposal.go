@@ -27,6 +27,10 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	IsExpedited bool `json:"is_expedited" yaml:"is_expedited"` // Whether the proposal is tallied with the expedited voting period and threshold
+
+	Proposer sdk.AccAddress `json:"proposer,omitempty" yaml:"proposer,omitempty"` // Address of the proposer, who can cancel the proposal
 }
 
 // NewProposal creates a new Proposal instance
@@ -53,10 +57,12 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
+  Proposer:           %s
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.IsExpedited, p.Proposer, p.GetDescription(),
 	)
 }
 
@@ -90,6 +96,7 @@ const (
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
 	StatusFailed        ProposalStatus = 0x05
+	StatusCanceled      ProposalStatus = 0x06
 )
 
 // ProposalStatusFromString turns a string into a ProposalStatus
@@ -110,6 +117,9 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 	case "Failed":
 		return StatusFailed, nil
 
+	case "Canceled":
+		return StatusCanceled, nil
+
 	case "":
 		return StatusNil, nil
 
@@ -125,7 +135,8 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusCanceled {
 		return true
 	}
 	return false
@@ -182,6 +193,9 @@ func (status ProposalStatus) String() string {
 	case StatusFailed:
 		return "Failed"
 
+	case StatusCanceled:
+		return "Canceled"
+
 	default:
 		return ""
 	}
@@ -201,8 +215,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText            string = "Text"
-	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+	ProposalTypeText string = "Text"
+	ProposalTypeExec string = "Exec"
 )
 
 // TextProposal defines a standard text proposal whose changes need to be
@@ -243,52 +257,9 @@ func (tp TextProposal) String() string {
 `, tp.Title, tp.Description)
 }
 
-// SoftwareUpgradeProposal defines a proposal for upgrading the network nodes
-// without the need of manually halting at a given height
-//
-// TODO: We have to add fields for SUP specific arguments e.g. commit hash,
-// upgrade date, etc.
-type SoftwareUpgradeProposal struct {
-	Title       string `json:"title" yaml:"title"`
-	Description string `json:"description" yaml:"description"`
-}
-
-// NewSoftwareUpgradeProposal creates a software upgrade proposal Content
-func NewSoftwareUpgradeProposal(title, description string) Content {
-	return SoftwareUpgradeProposal{title, description}
-}
-
-// Implements Content Interface
-var _ Content = SoftwareUpgradeProposal{}
-
-// GetTitle returns the proposal title
-func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
-
-// GetDescription returns the proposal description
-func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
-
-// ProposalRoute returns the proposal router key
-func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
-
-// ProposalType is "SoftwareUpgrade"
-func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
-
-// ValidateBasic validates the content's title and description of the proposal
-func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
-	return ValidateAbstract(DefaultCodespace, sup)
-}
-
-// String implements Stringer interface
-func (sup SoftwareUpgradeProposal) String() string {
-	return fmt.Sprintf(`Software Upgrade Proposal:
-  Title:       %s
-  Description: %s
-`, sup.Title, sup.Description)
-}
-
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText:            {},
-	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeText: {},
+	ProposalTypeExec: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -307,9 +278,6 @@ func ContentFromProposalType(title, desc, ty string) Content {
 	case ProposalTypeText:
 		return NewTextProposal(title, desc)
 
-	case ProposalTypeSoftwareUpgrade:
-		return NewSoftwareUpgradeProposal(title, desc)
-
 	default:
 		return nil
 	}
@@ -325,13 +293,12 @@ func IsValidProposalType(ty string) bool {
 }
 
 // ProposalHandler implements the Handler interface for governance module-based
-// proposals (ie. TextProposal and SoftwareUpgradeProposal). Since these are
-// merely signaling mechanisms at the moment and do not affect state, it
-// performs a no-op.
+// proposals (ie. TextProposal). Since these are merely signaling mechanisms at
+// the moment and do not affect state, it performs a no-op.
 func ProposalHandler(_ sdk.Context, c Content) sdk.Error {
 	switch c.ProposalType() {
-	case ProposalTypeText, ProposalTypeSoftwareUpgrade:
-		// both proposal types do not change state so this performs a no-op
+	case ProposalTypeText:
+		// text proposals do not change state so this performs a no-op
 		return nil
 
 	default:
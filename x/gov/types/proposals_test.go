@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +24,30 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecProposalValidateBasic(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	validMsg := NewMsgDeposit(addrs[0], 1, coins)
+	invalidMsg := NewMsgDeposit(sdk.AccAddress{}, 1, coins)
+
+	tests := []struct {
+		title      string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"Test Proposal", []sdk.Msg{validMsg}, true},
+		{"", []sdk.Msg{validMsg}, false},
+		{"Test Proposal", nil, false},
+		{"Test Proposal", []sdk.Msg{nil}, false},
+		{"Test Proposal", []sdk.Msg{validMsg, invalidMsg}, false},
+	}
+
+	for i, tc := range tests {
+		err := NewExecProposal(tc.title, "the purpose of this proposal is to test", tc.msgs).ValidateBasic()
+		if tc.expectPass {
+			require.Nil(t, err, "test: %v", i)
+		} else {
+			require.NotNil(t, err, "test: %v", i)
+		}
+	}
+}
@@ -20,6 +20,8 @@ const (
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+
+	ParamProposalType = "proposal_type"
 )
 
 // QueryProposalParams Params for queries:
@@ -8,16 +8,16 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) ValidatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
 		Address:             address,
@@ -68,6 +68,7 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 		tr.No.Equal(comp.No) &&
 		tr.NoWithVeto.Equal(comp.NoWithVeto)
 }
+
 // String implements stringer interface
 func (tr TallyResult) String() string {
 	return fmt.Sprintf(`Tally Result:
@@ -3,24 +3,47 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 // Vote
+//
+// Option is the single option of the votes cast before weighted votes were
+// introduced. It is deprecated and only kept, at its original position, so
+// that such votes can still be decoded from the store, genesis files and JSON;
+// use Options instead. MigrateLegacyOption converts it to Options.
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"`           //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`                       //  address of the voter
+	Option     VoteOption          `json:"option,omitempty" yaml:"option,omitempty"` //  deprecated, option chosen by the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`                   //  weighted options chosen by the voter
 }
 
-// NewVote creates a new Vote instance
+// NewVote creates a new Vote instance with the whole weight on a single option
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return NewWeightedVote(proposalID, voter, NewNonSplitVoteOption(option))
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power
+// across several options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalID: proposalID, Voter: voter, Options: options}
+}
+
+// MigrateLegacyOption returns the vote with its deprecated Option converted to
+// Options, giving the whole weight to that option.
+func (v Vote) MigrateLegacyOption() Vote {
+	if v.Option != OptionEmpty && len(v.Options) == 0 {
+		v.Options = NewNonSplitVoteOption(v.Option)
+	}
+	v.Option = OptionEmpty
+	return v
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.Options, v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +55,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -41,7 +64,7 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Options.Equals(comp.Options)
 }
 
 // Empty returns whether a vote is empty.
@@ -93,6 +116,101 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption defines the weight given to a vote option
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s:%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption returns the weighted options of a vote giving the
+// whole weight to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface.
+func (v WeightedVoteOptions) String() string {
+	options := make([]string, len(v))
+	for i, option := range v {
+		options[i] = option.String()
+	}
+	return strings.Join(options, ",")
+}
+
+// Equals returns whether two sets of weighted options are equal.
+func (v WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(v) != len(comp) {
+		return false
+	}
+	for i := range v {
+		if v[i].Option != comp[i].Option || !v[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// WeightedVoteOptionsFromString returns the weighted options from a string of
+// comma separated option=weight pairs, e.g. "Yes=0.6,No=0.4". It returns an
+// error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(pair, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns true if the options are valid, distinct,
+// with positive weights summing to one, and false otherwise.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	if len(options) == 0 {
+		return false
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[VoteOption]bool)
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) || usedOptions[option.Option] {
+			return false
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() {
+			return false
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteLegacyOptionJSON(t *testing.T) {
+	var vote Vote
+	bz := []byte(`{"proposal_id":"1","voter":"","option":"NoWithVeto"}`)
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &vote))
+	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.Empty(t, vote.Options)
+
+	vote = vote.MigrateLegacyOption()
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.True(t, vote.Options.Equals(NewNonSplitVoteOption(OptionNoWithVeto)))
+
+	bz, err := ModuleCdc.MarshalJSON(vote)
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.NotContains(t, fields, "option")
+}
+
+func TestVoteMigrateLegacyOptionKeepsOptions(t *testing.T) {
+	options := NewNonSplitVoteOption(OptionYes)
+	vote := Vote{ProposalID: 1, Option: OptionNo, Options: options}.MigrateLegacyOption()
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.True(t, vote.Options.Equals(options))
+}
@@ -0,0 +1,12 @@
+package htlc
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// EndBlocker refunds the open swaps which expire at the current block height
+// to their senders, and deletes the closed swaps whose retention period ends.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.RefundExpiredSwaps(ctx)
+	k.PruneClosedSwaps(ctx)
+}
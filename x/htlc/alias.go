@@ -0,0 +1,108 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/htlc/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types
+package htlc
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+const (
+	DefaultCodespace         = types.DefaultCodespace
+	CodeInvalidSwap          = types.CodeInvalidSwap
+	CodeInvalidHashLock      = types.CodeInvalidHashLock
+	CodeInvalidTimeLock      = types.CodeInvalidTimeLock
+	CodeInvalidSecret        = types.CodeInvalidSecret
+	CodeSwapExists           = types.CodeSwapExists
+	CodeSwapNotFound         = types.CodeSwapNotFound
+	CodeSwapNotOpen          = types.CodeSwapNotOpen
+	CodeSwapExpired          = types.CodeSwapExpired
+	EventTypeCreateSwap      = types.EventTypeCreateSwap
+	EventTypeClaimSwap       = types.EventTypeClaimSwap
+	EventTypeRefundSwap      = types.EventTypeRefundSwap
+	AttributeKeySwapID       = types.AttributeKeySwapID
+	AttributeKeySender       = types.AttributeKeySender
+	AttributeKeyRecipient    = types.AttributeKeyRecipient
+	AttributeKeyHashLock     = types.AttributeKeyHashLock
+	AttributeKeyExpireHeight = types.AttributeKeyExpireHeight
+	AttributeKeySecret       = types.AttributeKeySecret
+	AttributeValueCategory   = types.AttributeValueCategory
+	ModuleName               = types.ModuleName
+	StoreKey                 = types.StoreKey
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	TypeMsgCreateSwap        = types.TypeMsgCreateSwap
+	TypeMsgClaimSwap         = types.TypeMsgClaimSwap
+	QuerySwap                = types.QuerySwap
+	QuerySwapsBySender       = types.QuerySwapsBySender
+	QuerySwapsByRecipient    = types.QuerySwapsByRecipient
+	HashLockLength           = types.HashLockLength
+	SecretLength             = types.SecretLength
+	MinTimeLock              = types.MinTimeLock
+	MaxTimeLock              = types.MaxTimeLock
+	ClosedSwapRetention      = types.ClosedSwapRetention
+	StatusOpen               = types.StatusOpen
+	StatusCompleted          = types.StatusCompleted
+	StatusRefunded           = types.StatusRefunded
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterInvariants           = keeper.RegisterInvariants
+	EscrowedCoinsInvariant       = keeper.EscrowedCoinsInvariant
+	RegisterCodec                = types.RegisterCodec
+	ErrInvalidSwap               = types.ErrInvalidSwap
+	ErrInvalidHashLock           = types.ErrInvalidHashLock
+	ErrInvalidTimeLock           = types.ErrInvalidTimeLock
+	ErrInvalidSecret             = types.ErrInvalidSecret
+	ErrSwapExists                = types.ErrSwapExists
+	ErrSwapNotFound              = types.ErrSwapNotFound
+	ErrSwapNotOpen               = types.ErrSwapNotOpen
+	ErrSwapExpired               = types.ErrSwapExpired
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	GetSwapID                    = types.GetSwapID
+	GetSwapKey                   = types.GetSwapKey
+	GetSwapsBySenderKey          = types.GetSwapsBySenderKey
+	GetSwapBySenderKey           = types.GetSwapBySenderKey
+	GetSwapsByRecipientKey       = types.GetSwapsByRecipientKey
+	GetSwapByRecipientKey        = types.GetSwapByRecipientKey
+	GetSwapExpiryQueueKey        = types.GetSwapExpiryQueueKey
+	GetSwapExpiryQueueSwapKey    = types.GetSwapExpiryQueueSwapKey
+	GetSwapPruneQueueKey         = types.GetSwapPruneQueueKey
+	GetSwapPruneQueueSwapKey     = types.GetSwapPruneQueueSwapKey
+	SplitSwapIndexKey            = types.SplitSwapIndexKey
+	NewMsgCreateSwap             = types.NewMsgCreateSwap
+	NewMsgClaimSwap              = types.NewMsgClaimSwap
+	NewQuerySwapParams           = types.NewQuerySwapParams
+	NewQuerySwapsByAddressParams = types.NewQuerySwapsByAddressParams
+	SwapStatusFromString         = types.SwapStatusFromString
+	ValidSwapStatus              = types.ValidSwapStatus
+	NewSwap                      = types.NewSwap
+	HashLockMatches              = types.HashLockMatches
+
+	// variable aliases
+	ModuleCdc                = types.ModuleCdc
+	SwapKeyPrefix            = types.SwapKeyPrefix
+	SwapBySenderKeyPrefix    = types.SwapBySenderKeyPrefix
+	SwapByRecipientKeyPrefix = types.SwapByRecipientKeyPrefix
+	SwapExpiryQueueKeyPrefix = types.SwapExpiryQueueKeyPrefix
+	SwapPruneQueueKeyPrefix  = types.SwapPruneQueueKeyPrefix
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	GenesisState              = types.GenesisState
+	MsgCreateSwap             = types.MsgCreateSwap
+	MsgClaimSwap              = types.MsgClaimSwap
+	QuerySwapParams           = types.QuerySwapParams
+	QuerySwapsByAddressParams = types.QuerySwapsByAddressParams
+	SwapStatus                = types.SwapStatus
+	Swap                      = types.Swap
+	Swaps                     = types.Swaps
+)
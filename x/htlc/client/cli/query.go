@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the htlc module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	htlcQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the htlc module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	htlcQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQuerySwap(cdc),
+			GetCmdQuerySwapsBySender(cdc),
+			GetCmdQuerySwapsByRecipient(cdc),
+		)...,
+	)
+
+	return htlcQueryCmd
+}
+
+// GetCmdQuerySwap implements the query swap command.
+func GetCmdQuerySwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap [swap-id]",
+		Short: "Query a swap by its ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of a hashed time-locked swap by its hex encoded ID.
+
+Example:
+$ %s query %s swap 0b6f5e0c1a4ae1fbd3b3f4c5b1d0e6a2c64d0fb9b85d7f52e5d6e8a3c4b0f1d2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid swap ID: %s", err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySwapParams(swapID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwap)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var swap types.Swap
+			if err := cdc.UnmarshalJSON(res, &swap); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(swap)
+		},
+	}
+}
+
+// GetCmdQuerySwapsBySender implements the query swaps by sender command.
+func GetCmdQuerySwapsBySender(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swaps-by-sender [sender]",
+		Short: "Query all the swaps created by a sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of all the hashed time-locked swaps created by a sender.
+
+Example:
+$ %s query %s swaps-by-sender cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySwapsByAddress(cdc, types.QuerySwapsBySender, args[0])
+		},
+	}
+}
+
+// GetCmdQuerySwapsByRecipient implements the query swaps by recipient command.
+func GetCmdQuerySwapsByRecipient(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swaps-by-recipient [recipient]",
+		Short: "Query all the swaps to be claimed by a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of all the hashed time-locked swaps to be claimed by a recipient.
+
+Example:
+$ %s query %s swaps-by-recipient cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySwapsByAddress(cdc, types.QuerySwapsByRecipient, args[0])
+		},
+	}
+}
+
+func querySwapsByAddress(cdc *codec.Codec, queryRoute, addrStr string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	addr, err := sdk.AccAddressFromBech32(addrStr)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapsByAddressParams(addr))
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, queryRoute)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var swaps types.Swaps
+	if err := cdc.UnmarshalJSON(res, &swaps); err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(swaps)
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+// GetTxCmd returns the transaction commands for the htlc module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	htlcTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Hashed time-locked contract transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	htlcTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateSwap(cdc),
+		GetCmdClaimSwap(cdc),
+	)...)
+
+	return htlcTxCmd
+}
+
+// GetCmdCreateSwap implements the create swap command.
+func GetCmdCreateSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [recipient] [amount] [hash-lock] [time-lock]",
+		Short: "Lock coins in a hashed time-locked swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock coins of the sender in a swap which the recipient can claim by revealing
+the secret whose SHA-256 hash is the hex encoded hash lock. If the swap is not
+claimed within the time lock, given in blocks, the coins are refunded to the
+sender.
+
+Example:
+$ %s tx %s create cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000stake \
+268a323064e3723615352674842ebddb757caa8209af7e52ce741f07be476d85 100 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			hashLock, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid hash lock: %s", err)
+			}
+
+			timeLock, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid time lock: %s", err)
+			}
+
+			msg := types.NewMsgCreateSwap(cliCtx.GetFromAddress(), recipient, amount, hashLock, timeLock)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClaimSwap implements the claim swap command.
+func GetCmdClaimSwap(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [swap-id] [secret]",
+		Short: "Claim a swap by revealing its secret",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim an open swap by revealing the hex encoded secret whose SHA-256 hash is
+the hash lock of the swap. The coins of the swap are sent to its recipient.
+
+Example:
+$ %s tx %s claim 0b6f5e0c1a4ae1fbd3b3f4c5b1d0e6a2c64d0fb9b85d7f52e5d6e8a3c4b0f1d2 \
+6d79736563726574323232323232323232323232323232323232323232323232 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid swap ID: %s", err)
+			}
+
+			secret, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid secret: %s", err)
+			}
+
+			msg := types.NewMsgClaimSwap(cliCtx.GetFromAddress(), swapID, secret)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/htlc/swaps", createSwapHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/htlc/swaps/{%s}/claim", RestParamSwapID), claimSwapHandlerFn(cliCtx)).Methods("POST")
+}
+
+type (
+	// CreateSwapReq defines the properties of a create swap request's body.
+	// The sender is the sender of the base request.
+	CreateSwapReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+		HashLock  cmn.HexBytes   `json:"hash_lock" yaml:"hash_lock"`
+		TimeLock  int64          `json:"time_lock" yaml:"time_lock"`
+	}
+
+	// ClaimSwapReq defines the properties of a claim swap request's body.
+	ClaimSwapReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Secret  cmn.HexBytes `json:"secret" yaml:"secret"`
+	}
+)
+
+func createSwapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateSwapReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateSwap(sender, req.Recipient, req.Amount, req.HashLock, req.TimeLock)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func claimSwapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		swapID, err := hex.DecodeString(mux.Vars(r)[RestParamSwapID])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ClaimSwapReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaimSwap(sender, swapID, req.Secret)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
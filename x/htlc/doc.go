@@ -0,0 +1,21 @@
+/*
+Package htlc implements hashed time-locked contracts, which allow coins to be
+swapped atomically with another chain.
+
+A swap is created with a MsgCreateSwap, which locks coins of the sender in the
+htlc module account under a SHA-256 hash lock for a number of blocks. Until
+the swap expires, anyone may claim it with a MsgClaimSwap by revealing the
+secret whose hash is the hash lock, which sends the locked coins to the
+recipient of the swap. The revealed secret is recorded in the swap and in the
+claim_swap event, so that the counterparty can use it to claim the matching
+swap on the other chain.
+
+Swaps which have not been claimed by their expire height are refunded to
+their senders at the end of that block.
+
+The ID of a swap is the SHA-256 hash of its hash lock followed by the address
+of its sender. Completed and refunded swaps are kept in the store, where they
+can be queried by ID, sender or recipient, for ClosedSwapRetention blocks
+after their expire height and are deleted afterwards.
+*/
+package htlc
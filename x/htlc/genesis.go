@@ -0,0 +1,46 @@
+package htlc
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+// InitGenesis initializes the htlc module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	// check if the module account exists
+	moduleAcc := k.GetHTLCAccount(ctx)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
+	}
+
+	var escrowed sdk.Coins
+	for _, swap := range gs.Swaps {
+		k.SetSwap(ctx, swap)
+		if swap.IsOpen() {
+			k.InsertSwapExpiryQueue(ctx, swap.ExpireHeight, swap.ID())
+			escrowed = escrowed.Add(swap.Amount)
+		} else {
+			k.InsertSwapPruneQueue(ctx, swap.PruneHeight(), swap.ID())
+		}
+	}
+
+	// add coins if not provided on genesis
+	if moduleAcc.GetCoins().IsZero() {
+		if err := moduleAcc.SetCoins(escrowed); err != nil {
+			panic(err)
+		}
+		supplyKeeper.SetModuleAccount(ctx, moduleAcc)
+	}
+}
+
+// ExportGenesis returns the htlc module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAllSwaps(ctx))
+}
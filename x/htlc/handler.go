@@ -0,0 +1,80 @@
+package htlc
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+// NewHandler returns a handler for htlc messages
+func NewHandler(k Keeper, bankKeeper types.BankKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgCreateSwap:
+			return handleMsgCreateSwap(ctx, k, bankKeeper, msg)
+
+		case MsgClaimSwap:
+			return handleMsgClaimSwap(ctx, k, bankKeeper, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgCreateSwap(ctx sdk.Context, k Keeper, bankKeeper types.BankKeeper, msg MsgCreateSwap) sdk.Result {
+	if err := bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return err.Result()
+	}
+
+	if bankKeeper.BlacklistedAddr(msg.Recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.Recipient)).Result()
+	}
+
+	swap := NewSwap(msg.Sender, msg.Recipient, msg.Amount, msg.HashLock, ctx.BlockHeight()+msg.TimeLock)
+
+	id, err := k.CreateSwap(ctx, swap)
+	if err != nil {
+		return err.Result()
+	}
+
+	result := messageResult(ctx, msg.Sender)
+	result.Data = id
+	return result
+}
+
+func handleMsgClaimSwap(ctx sdk.Context, k Keeper, bankKeeper types.BankKeeper, msg MsgClaimSwap) sdk.Result {
+	// swaps of denominations whose transfers were disabled since their
+	// creation cannot be claimed, and are refunded once they expire
+	swap, found := k.GetSwap(ctx, msg.SwapID)
+	if !found {
+		return ErrSwapNotFound(k.Codespace(), msg.SwapID).Result()
+	}
+	if err := bankKeeper.IsSendEnabledCoins(ctx, swap.Amount...); err != nil {
+		return err.Result()
+	}
+
+	if err := k.ClaimSwap(ctx, msg.SwapID, msg.Secret); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Sender)
+}
+
+// messageResult emits the message event for a handled message sent by sender
+// and returns the result holding all the events emitted while handling it.
+func messageResult(ctx sdk.Context, sender sdk.AccAddress) sdk.Result {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+// RegisterInvariants registers all htlc invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrowed-coins", EscrowedCoinsInvariant(k))
+}
+
+// EscrowedCoinsInvariant checks that the htlc module account holds the coins
+// of all the open swaps
+func EscrowedCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var escrowed sdk.Coins
+		k.IterateSwaps(ctx, func(swap types.Swap) bool {
+			if swap.IsOpen() {
+				escrowed = escrowed.Add(swap.Amount)
+			}
+			return false
+		})
+
+		balance := k.GetHTLCAccount(ctx).GetCoins()
+		broken := !balance.IsEqual(escrowed)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed coins", fmt.Sprintf(
+			"\thtlc module account coins: %s\n\tsum of open swap amounts: %s\n",
+			balance, escrowed)), broken
+	}
+}
@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+	supplyexported "github.com/hyperspeednetwork/hsnhub/x/supply/exported"
+)
+
+// Keeper defines the htlc module's keeper. The coins of open swaps are held
+// in escrow by the htlc module account.
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	supplyKeeper types.SupplyKeeper
+	codespace    sdk.CodespaceType
+}
+
+// NewKeeper creates a new htlc Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType,
+) Keeper {
+
+	// ensure the htlc module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		supplyKeeper: supplyKeeper,
+		codespace:    codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the htlc module's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetHTLCAccount returns the htlc ModuleAccount, which holds the coins of all
+// the open swaps.
+func (k Keeper) GetHTLCAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// CreateSwap locks the amount of the swap from the sender's balance in the
+// htlc module account and returns the ID of the new swap.
+func (k Keeper) CreateSwap(ctx sdk.Context, swap types.Swap) ([]byte, sdk.Error) {
+	id := swap.ID()
+	if _, found := k.GetSwap(ctx, id); found {
+		return nil, types.ErrSwapExists(k.codespace, id)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, swap.Sender, types.ModuleName, swap.Amount); err != nil {
+		return nil, err
+	}
+
+	k.SetSwap(ctx, swap)
+	k.InsertSwapExpiryQueue(ctx, swap.ExpireHeight, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, id.String()),
+			sdk.NewAttribute(types.AttributeKeySender, swap.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, swap.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, swap.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", swap.ExpireHeight)),
+		),
+	)
+
+	return id, nil
+}
+
+// ClaimSwap completes an open swap which has not expired yet, sending its
+// coins to the recipient, if the SHA-256 hash of the secret is the hash lock
+// of the swap.
+func (k Keeper) ClaimSwap(ctx sdk.Context, swapID, secret []byte) sdk.Error {
+	swap, found := k.GetSwap(ctx, swapID)
+	if !found {
+		return types.ErrSwapNotFound(k.codespace, swapID)
+	}
+	if !swap.IsOpen() {
+		return types.ErrSwapNotOpen(k.codespace, swapID, swap.Status)
+	}
+	if ctx.BlockHeight() > swap.ExpireHeight {
+		return types.ErrSwapExpired(k.codespace, swapID, swap.ExpireHeight)
+	}
+	if !types.HashLockMatches(swap.HashLock, secret) {
+		return types.ErrInvalidSecret(k.codespace, secret)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Recipient, swap.Amount); err != nil {
+		return err
+	}
+
+	swap.Status = types.StatusCompleted
+	swap.Secret = secret
+	k.SetSwap(ctx, swap)
+	k.RemoveFromSwapExpiryQueue(ctx, swap.ExpireHeight, swapID)
+	k.InsertSwapPruneQueue(ctx, swap.PruneHeight(), swapID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, swap.ID().String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, swap.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySecret, swap.Secret.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundSwap refunds the coins of an open swap to its sender.
+func (k Keeper) RefundSwap(ctx sdk.Context, swapID []byte) sdk.Error {
+	swap, found := k.GetSwap(ctx, swapID)
+	if !found {
+		return types.ErrSwapNotFound(k.codespace, swapID)
+	}
+	if !swap.IsOpen() {
+		return types.ErrSwapNotOpen(k.codespace, swapID, swap.Status)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, swap.Sender, swap.Amount); err != nil {
+		return err
+	}
+
+	swap.Status = types.StatusRefunded
+	k.SetSwap(ctx, swap)
+	k.RemoveFromSwapExpiryQueue(ctx, swap.ExpireHeight, swapID)
+	k.InsertSwapPruneQueue(ctx, swap.PruneHeight(), swapID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, swap.ID().String()),
+			sdk.NewAttribute(types.AttributeKeySender, swap.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swap.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundExpiredSwaps refunds all the open swaps whose expire height is lower
+// than or equal to the current block height.
+func (k Keeper) RefundExpiredSwaps(ctx sdk.Context) {
+	k.IterateExpiredSwapQueue(ctx, ctx.BlockHeight(), func(swapID []byte) bool {
+		if err := k.RefundSwap(ctx, swapID); err != nil {
+			// the escrowed coins of an open swap are always held by the
+			// module account
+			panic(err)
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("refunded expired swap %X", swapID))
+		return false
+	})
+}
+
+// PruneClosedSwaps deletes all the completed and refunded swaps whose prune
+// height is lower than or equal to the current block height.
+func (k Keeper) PruneClosedSwaps(ctx sdk.Context) {
+	k.IteratePrunableSwapQueue(ctx, ctx.BlockHeight(), func(swapID []byte) bool {
+		swap, found := k.GetSwap(ctx, swapID)
+		if !found {
+			panic(fmt.Sprintf("swap %X is in the prune queue but does not exist", swapID))
+		}
+
+		k.DeleteSwap(ctx, swap)
+		k.RemoveFromSwapPruneQueue(ctx, swap.PruneHeight(), swapID)
+		return false
+	})
+}
+
+// GetSwap returns the swap with the given ID
+func (k Keeper) GetSwap(ctx sdk.Context, swapID []byte) (swap types.Swap, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetSwapKey(swapID))
+	if bz == nil {
+		return swap, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &swap)
+	return swap, true
+}
+
+// SetSwap stores a swap and indexes it by its sender and recipient
+func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	id := swap.ID()
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(swap)
+	store.Set(types.GetSwapKey(id), bz)
+	store.Set(types.GetSwapBySenderKey(swap.Sender, id), []byte{})
+	store.Set(types.GetSwapByRecipientKey(swap.Recipient, id), []byte{})
+}
+
+// DeleteSwap deletes a swap and its sender and recipient indexes
+func (k Keeper) DeleteSwap(ctx sdk.Context, swap types.Swap) {
+	store := ctx.KVStore(k.storeKey)
+	id := swap.ID()
+
+	store.Delete(types.GetSwapKey(id))
+	store.Delete(types.GetSwapBySenderKey(swap.Sender, id))
+	store.Delete(types.GetSwapByRecipientKey(swap.Recipient, id))
+}
+
+// IterateSwaps iterates over all the swaps. For each swap, cb will be called.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateSwaps(ctx sdk.Context, cb func(types.Swap) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SwapKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var swap types.Swap
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &swap)
+
+		if cb(swap) {
+			break
+		}
+	}
+}
+
+// GetAllSwaps returns all the swaps
+func (k Keeper) GetAllSwaps(ctx sdk.Context) []types.Swap {
+	swaps := []types.Swap{}
+	k.IterateSwaps(ctx, func(swap types.Swap) bool {
+		swaps = append(swaps, swap)
+		return false
+	})
+
+	return swaps
+}
+
+// GetSwapsBySender returns all the swaps created by the given sender
+func (k Keeper) GetSwapsBySender(ctx sdk.Context, sender sdk.AccAddress) []types.Swap {
+	return k.getIndexedSwaps(ctx, types.GetSwapsBySenderKey(sender))
+}
+
+// GetSwapsByRecipient returns all the swaps to be claimed by the given
+// recipient
+func (k Keeper) GetSwapsByRecipient(ctx sdk.Context, recipient sdk.AccAddress) []types.Swap {
+	return k.getIndexedSwaps(ctx, types.GetSwapsByRecipientKey(recipient))
+}
+
+func (k Keeper) getIndexedSwaps(ctx sdk.Context, prefix []byte) []types.Swap {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	swaps := []types.Swap{}
+	for ; iterator.Valid(); iterator.Next() {
+		swap, found := k.GetSwap(ctx, types.SplitSwapIndexKey(iterator.Key()))
+		if !found {
+			panic(fmt.Sprintf("swap %X is indexed but does not exist", types.SplitSwapIndexKey(iterator.Key())))
+		}
+		swaps = append(swaps, swap)
+	}
+
+	return swaps
+}
+
+// InsertSwapExpiryQueue inserts a swap ID into the queue of swaps expiring at
+// the given height
+func (k Keeper) InsertSwapExpiryQueue(ctx sdk.Context, expireHeight int64, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSwapExpiryQueueSwapKey(expireHeight, swapID), []byte{})
+}
+
+// RemoveFromSwapExpiryQueue removes a swap ID from the queue of swaps expiring
+// at the given height
+func (k Keeper) RemoveFromSwapExpiryQueue(ctx sdk.Context, expireHeight int64, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapExpiryQueueSwapKey(expireHeight, swapID))
+}
+
+// IterateExpiredSwapQueue iterates over the IDs of the open swaps expiring at
+// or before the given height. For each swap ID, cb will be called. If the cb
+// returns true, the iterator will close and stop.
+func (k Keeper) IterateExpiredSwapQueue(ctx sdk.Context, height int64, cb func(swapID []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SwapExpiryQueueKeyPrefix, sdk.PrefixEndBytes(types.GetSwapExpiryQueueKey(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitSwapIndexKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// InsertSwapPruneQueue inserts a swap ID into the queue of closed swaps to be
+// deleted at the given height
+func (k Keeper) InsertSwapPruneQueue(ctx sdk.Context, pruneHeight int64, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSwapPruneQueueSwapKey(pruneHeight, swapID), []byte{})
+}
+
+// RemoveFromSwapPruneQueue removes a swap ID from the queue of closed swaps to
+// be deleted at the given height
+func (k Keeper) RemoveFromSwapPruneQueue(ctx sdk.Context, pruneHeight int64, swapID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapPruneQueueSwapKey(pruneHeight, swapID))
+}
+
+// IteratePrunableSwapQueue iterates over the IDs of the closed swaps to be
+// deleted at or before the given height. For each swap ID, cb will be called.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePrunableSwapQueue(ctx sdk.Context, height int64, cb func(swapID []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SwapPruneQueueKeyPrefix, sdk.PrefixEndBytes(types.GetSwapPruneQueueKey(height)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.SplitSwapIndexKey(iterator.Key())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+	"github.com/hyperspeednetwork/hsnhub/x/params"
+	"github.com/hyperspeednetwork/hsnhub/x/supply"
+)
+
+var (
+	senderAddr    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	secret   = []byte("abcdefghijklmnopqrstuvwxyz012345")
+	hashLock = func() []byte { h := sha256.Sum256(secret); return h[:] }()
+
+	initialCoins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyHTLC := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyHTLC, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "htlc-chain", Height: 10}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, make(map[string]bool))
+
+	maccPerms := map[string][]string{
+		types.ModuleName: nil,
+	}
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
+
+	acc := ak.NewAccountWithAddress(ctx, senderAddr)
+	require.NoError(t, acc.SetCoins(initialCoins))
+	ak.SetAccount(ctx, acc)
+	sk.SetSupply(ctx, supply.NewSupply(initialCoins))
+
+	k := NewKeeper(cdc, keyHTLC, sk, types.DefaultCodespace)
+
+	return ctx, ak, k
+}
+
+func createTestSwap(t *testing.T, ctx sdk.Context, k Keeper, expireHeight int64) []byte {
+	swap := types.NewSwap(senderAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), hashLock, expireHeight)
+
+	id, err := k.CreateSwap(ctx, swap)
+	require.Nil(t, err)
+	require.Equal(t, types.GetSwapID(hashLock, senderAddr), id)
+
+	return id
+}
+
+func TestCreateSwap(t *testing.T) {
+	ctx, ak, k := createTestInput(t)
+
+	id := createTestSwap(t, ctx, k, 100)
+
+	swap, found := k.GetSwap(ctx, id)
+	require.True(t, found)
+	require.True(t, swap.IsOpen())
+	require.Equal(t, int64(100), swap.ExpireHeight)
+
+	require.Equal(t, sdk.NewInt(900), ak.GetAccount(ctx, senderAddr).GetCoins().AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewInt(100), k.GetHTLCAccount(ctx).GetCoins().AmountOf(sdk.DefaultBondDenom))
+
+	// the same sender cannot reuse a hash lock
+	_, err := k.CreateSwap(ctx, swap)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSwapExists, err.Code())
+
+	// the sender must have the coins to lock
+	tooMuch := types.NewSwap(recipientAddr, senderAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), hashLock, 100)
+	_, err = k.CreateSwap(ctx, tooMuch)
+	require.NotNil(t, err)
+
+	require.Len(t, k.GetSwapsBySender(ctx, senderAddr), 1)
+	require.Len(t, k.GetSwapsByRecipient(ctx, recipientAddr), 1)
+	require.Empty(t, k.GetSwapsBySender(ctx, recipientAddr))
+	require.Empty(t, k.GetSwapsByRecipient(ctx, senderAddr))
+}
+
+func TestClaimSwap(t *testing.T) {
+	ctx, ak, k := createTestInput(t)
+
+	id := createTestSwap(t, ctx, k, 100)
+
+	err := k.ClaimSwap(ctx, id, []byte("bbcdefghijklmnopqrstuvwxyz012345"))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidSecret, err.Code())
+
+	err = k.ClaimSwap(ctx, types.GetSwapID(hashLock, recipientAddr), secret)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSwapNotFound, err.Code())
+
+	// swaps cannot be claimed past their expire height
+	err = k.ClaimSwap(ctx.WithBlockHeight(101), id, secret)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSwapExpired, err.Code())
+
+	require.Nil(t, k.ClaimSwap(ctx.WithBlockHeight(100), id, secret))
+
+	swap, found := k.GetSwap(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.StatusCompleted, swap.Status)
+	require.Equal(t, secret, []byte(swap.Secret))
+
+	require.Equal(t, sdk.NewInt(100), ak.GetAccount(ctx, recipientAddr).GetCoins().AmountOf(sdk.DefaultBondDenom))
+	require.True(t, k.GetHTLCAccount(ctx).GetCoins().Empty())
+
+	// swaps can only be claimed once
+	err = k.ClaimSwap(ctx, id, secret)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSwapNotOpen, err.Code())
+
+	// completed swaps are not refunded
+	k.RefundExpiredSwaps(ctx.WithBlockHeight(100))
+	require.Equal(t, sdk.NewInt(900), ak.GetAccount(ctx, senderAddr).GetCoins().AmountOf(sdk.DefaultBondDenom))
+
+	_, broken := EscrowedCoinsInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestRefundExpiredSwaps(t *testing.T) {
+	ctx, ak, k := createTestInput(t)
+
+	id := createTestSwap(t, ctx, k, 100)
+
+	k.RefundExpiredSwaps(ctx.WithBlockHeight(99))
+	swap, _ := k.GetSwap(ctx, id)
+	require.True(t, swap.IsOpen())
+
+	k.RefundExpiredSwaps(ctx.WithBlockHeight(100))
+	swap, _ = k.GetSwap(ctx, id)
+	require.Equal(t, types.StatusRefunded, swap.Status)
+
+	require.Equal(t, initialCoins, ak.GetAccount(ctx, senderAddr).GetCoins())
+	require.True(t, k.GetHTLCAccount(ctx).GetCoins().Empty())
+
+	// refunded swaps cannot be claimed
+	err := k.ClaimSwap(ctx, id, secret)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeSwapNotOpen, err.Code())
+
+	_, broken := EscrowedCoinsInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestPruneClosedSwaps(t *testing.T) {
+	ctx, _, k := createTestInput(t)
+
+	id := createTestSwap(t, ctx, k, 100)
+	require.Nil(t, k.ClaimSwap(ctx, id, secret))
+
+	pruneHeight := int64(100 + types.ClosedSwapRetention)
+
+	k.PruneClosedSwaps(ctx.WithBlockHeight(pruneHeight - 1))
+	_, found := k.GetSwap(ctx, id)
+	require.True(t, found)
+
+	k.PruneClosedSwaps(ctx.WithBlockHeight(pruneHeight))
+	_, found = k.GetSwap(ctx, id)
+	require.False(t, found)
+	require.Empty(t, k.GetSwapsBySender(ctx, senderAddr))
+	require.Empty(t, k.GetSwapsByRecipient(ctx, recipientAddr))
+
+	// refunded swaps are pruned as well
+	id = createTestSwap(t, ctx, k, 200)
+	k.RefundExpiredSwaps(ctx.WithBlockHeight(200))
+	k.PruneClosedSwaps(ctx.WithBlockHeight(200 + types.ClosedSwapRetention))
+	_, found = k.GetSwap(ctx, id)
+	require.False(t, found)
+
+	_, broken := EscrowedCoinsInvariant(k)(ctx)
+	require.False(t, broken)
+}
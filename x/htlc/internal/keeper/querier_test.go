@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/x/htlc/internal/types"
+)
+
+func TestQuerier(t *testing.T) {
+	ctx, _, k := createTestInput(t)
+	querier := NewQuerier(k)
+
+	id := createTestSwap(t, ctx, k, 100)
+	expected, _ := k.GetSwap(ctx, id)
+
+	bz, err := k.cdc.MarshalJSON(types.NewQuerySwapParams(id))
+	require.NoError(t, err)
+
+	res, sdkErr := querier(ctx, []string{types.QuerySwap}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+
+	var swap types.Swap
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &swap))
+	require.Equal(t, expected.String(), swap.String())
+
+	bz, err = k.cdc.MarshalJSON(types.NewQuerySwapParams(types.GetSwapID(hashLock, recipientAddr)))
+	require.NoError(t, err)
+
+	_, sdkErr = querier(ctx, []string{types.QuerySwap}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, sdkErr)
+
+	bz, err = k.cdc.MarshalJSON(types.NewQuerySwapsByAddressParams(senderAddr))
+	require.NoError(t, err)
+
+	var swaps []types.Swap
+	res, sdkErr = querier(ctx, []string{types.QuerySwapsBySender}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &swaps))
+	require.Len(t, swaps, 1)
+	require.Equal(t, expected.ID(), swaps[0].ID())
+
+	res, sdkErr = querier(ctx, []string{types.QuerySwapsByRecipient}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+	require.NoError(t, k.cdc.UnmarshalJSON(res, &swaps))
+	require.Empty(t, swaps)
+
+	_, sdkErr = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.NotNil(t, sdkErr)
+}
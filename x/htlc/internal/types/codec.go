@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/codec"
+)
+
+// ModuleCdc defines the htlc module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// htlc module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateSwap{}, "cosmos-sdk/MsgCreateSwap", nil)
+	cdc.RegisterConcrete(MsgClaimSwap{}, "cosmos-sdk/MsgClaimSwap", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidSwap     sdk.CodeType = 1
+	CodeInvalidHashLock sdk.CodeType = 2
+	CodeInvalidTimeLock sdk.CodeType = 3
+	CodeInvalidSecret   sdk.CodeType = 4
+	CodeSwapExists      sdk.CodeType = 5
+	CodeSwapNotFound    sdk.CodeType = 6
+	CodeSwapNotOpen     sdk.CodeType = 7
+	CodeSwapExpired     sdk.CodeType = 8
+)
+
+// ErrInvalidSwap returns a typed error when a swap is invalid
+func ErrInvalidSwap(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSwap, fmt.Sprintf("invalid swap: %s", msg))
+}
+
+// ErrInvalidHashLock returns a typed error when a hash lock is not a SHA-256
+// hash
+func ErrInvalidHashLock(codespace sdk.CodespaceType, hashLock []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHashLock,
+		fmt.Sprintf("invalid hash lock %X: must be %d bytes long", hashLock, HashLockLength))
+}
+
+// ErrInvalidTimeLock returns a typed error when a time lock is out of bounds
+func ErrInvalidTimeLock(codespace sdk.CodespaceType, timeLock int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTimeLock,
+		fmt.Sprintf("invalid time lock %d: must be between %d and %d blocks", timeLock, MinTimeLock, MaxTimeLock))
+}
+
+// ErrInvalidSecret returns a typed error when a secret does not hash to the
+// hash lock of a swap
+func ErrInvalidSecret(codespace sdk.CodespaceType, secret []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSecret, fmt.Sprintf("secret %X does not match the hash lock", secret))
+}
+
+// ErrSwapExists returns a typed error when a swap with the same ID already
+// exists
+func ErrSwapExists(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapExists, fmt.Sprintf("swap %X already exists", swapID))
+}
+
+// ErrSwapNotFound returns a typed error when no swap exists for the given ID
+func ErrSwapNotFound(codespace sdk.CodespaceType, swapID []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapNotFound, fmt.Sprintf("swap %X does not exist", swapID))
+}
+
+// ErrSwapNotOpen returns a typed error when a swap has already been completed
+// or refunded
+func ErrSwapNotOpen(codespace sdk.CodespaceType, swapID []byte, status SwapStatus) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapNotOpen, fmt.Sprintf("swap %X is not open: %s", swapID, status))
+}
+
+// ErrSwapExpired returns a typed error when claiming a swap past its expire
+// height
+func ErrSwapExpired(codespace sdk.CodespaceType, swapID []byte, expireHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapExpired, fmt.Sprintf("swap %X expired at height %d", swapID, expireHeight))
+}
@@ -0,0 +1,17 @@
+package types
+
+// htlc module event types
+const (
+	EventTypeCreateSwap = "create_swap"
+	EventTypeClaimSwap  = "claim_swap"
+	EventTypeRefundSwap = "refund_swap"
+
+	AttributeKeySwapID       = "swap_id"
+	AttributeKeySender       = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyHashLock     = "hash_lock"
+	AttributeKeyExpireHeight = "expire_height"
+	AttributeKeySecret       = "secret"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,22 @@
+package types // noalias
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	supplyexported "github.com/hyperspeednetwork/hsnhub/x/supply/exported"
+)
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	SetModuleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) sdk.Error
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the htlc module's genesis state.
+type GenesisState struct {
+	Swaps []Swap `json:"swaps" yaml:"swaps"`
+}
+
+// NewGenesisState creates a new genesis state for the htlc module.
+func NewGenesisState(swaps []Swap) GenesisState {
+	return GenesisState{Swaps: swaps}
+}
+
+// DefaultGenesisState returns the htlc module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Swaps: []Swap{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenIDs := make(map[string]bool)
+	for _, swap := range gs.Swaps {
+		id := swap.ID().String()
+		if seenIDs[id] {
+			return fmt.Errorf("duplicate swap %s", id)
+		}
+		if err := swap.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenIDs[id] = true
+	}
+
+	return nil
+}
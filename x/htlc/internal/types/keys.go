@@ -0,0 +1,108 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "htlc"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for htlc store
+// Items are stored with the following key: values
+//
+// - 0x00<swapID_Bytes>: Swap
+//
+// - 0x01<sender_Bytes><swapID_Bytes>: []byte{}
+//
+// - 0x02<recipient_Bytes><swapID_Bytes>: []byte{}
+//
+// - 0x03<expireHeight_Bytes><swapID_Bytes>: []byte{}
+//
+// - 0x04<pruneHeight_Bytes><swapID_Bytes>: []byte{}
+var (
+	SwapKeyPrefix            = []byte{0x00}
+	SwapBySenderKeyPrefix    = []byte{0x01}
+	SwapByRecipientKeyPrefix = []byte{0x02}
+	SwapExpiryQueueKeyPrefix = []byte{0x03}
+	SwapPruneQueueKeyPrefix  = []byte{0x04}
+)
+
+// GetSwapID returns the ID of the swap locked by the given hash lock and
+// created by the given sender.
+func GetSwapID(hashLock []byte, sender sdk.AccAddress) []byte {
+	hash := sha256.Sum256(append(append([]byte{}, hashLock...), sender...))
+	return hash[:]
+}
+
+// GetSwapKey returns the key of the swap with the given ID
+func GetSwapKey(swapID []byte) []byte {
+	return append(SwapKeyPrefix, swapID...)
+}
+
+// GetSwapsBySenderKey returns the key prefix of the swaps created by the given
+// sender
+func GetSwapsBySenderKey(sender sdk.AccAddress) []byte {
+	return append(SwapBySenderKeyPrefix, sender.Bytes()...)
+}
+
+// GetSwapBySenderKey returns the key of the sender index of a swap
+func GetSwapBySenderKey(sender sdk.AccAddress, swapID []byte) []byte {
+	return append(GetSwapsBySenderKey(sender), swapID...)
+}
+
+// GetSwapsByRecipientKey returns the key prefix of the swaps to be claimed by
+// the given recipient
+func GetSwapsByRecipientKey(recipient sdk.AccAddress) []byte {
+	return append(SwapByRecipientKeyPrefix, recipient.Bytes()...)
+}
+
+// GetSwapByRecipientKey returns the key of the recipient index of a swap
+func GetSwapByRecipientKey(recipient sdk.AccAddress, swapID []byte) []byte {
+	return append(GetSwapsByRecipientKey(recipient), swapID...)
+}
+
+// GetSwapExpiryQueueKey returns the key prefix of the swaps expiring at the
+// given height
+func GetSwapExpiryQueueKey(expireHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(expireHeight))
+	return append(SwapExpiryQueueKeyPrefix, bz...)
+}
+
+// GetSwapExpiryQueueSwapKey returns the key of a swap in the expiry queue
+func GetSwapExpiryQueueSwapKey(expireHeight int64, swapID []byte) []byte {
+	return append(GetSwapExpiryQueueKey(expireHeight), swapID...)
+}
+
+// GetSwapPruneQueueKey returns the key prefix of the closed swaps to be
+// deleted at the given height
+func GetSwapPruneQueueKey(pruneHeight int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(pruneHeight))
+	return append(SwapPruneQueueKeyPrefix, bz...)
+}
+
+// GetSwapPruneQueueSwapKey returns the key of a swap in the prune queue
+func GetSwapPruneQueueSwapKey(pruneHeight int64, swapID []byte) []byte {
+	return append(GetSwapPruneQueueKey(pruneHeight), swapID...)
+}
+
+// SplitSwapIndexKey returns the swap ID from a sender, recipient, expiry
+// queue or prune queue index key
+func SplitSwapIndexKey(key []byte) []byte {
+	return key[len(key)-sha256.Size:]
+}
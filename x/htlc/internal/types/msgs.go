@@ -0,0 +1,125 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Message types for the htlc module
+const (
+	TypeMsgCreateSwap = "create_swap"
+	TypeMsgClaimSwap  = "claim_swap"
+)
+
+var (
+	_ sdk.Msg = MsgCreateSwap{}
+	_ sdk.Msg = MsgClaimSwap{}
+)
+
+// MsgCreateSwap locks coins of the sender in escrow under a hash lock for the
+// given number of blocks.
+type MsgCreateSwap struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	HashLock  cmn.HexBytes   `json:"hash_lock" yaml:"hash_lock"`
+	TimeLock  int64          `json:"time_lock" yaml:"time_lock"`
+}
+
+// NewMsgCreateSwap creates a new MsgCreateSwap object.
+func NewMsgCreateSwap(sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, timeLock int64) MsgCreateSwap {
+	return MsgCreateSwap{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+		HashLock:  hashLock,
+		TimeLock:  timeLock,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateSwap) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateSwap) Type() string { return TypeMsgCreateSwap }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateSwap) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	if len(msg.HashLock) != HashLockLength {
+		return ErrInvalidHashLock(DefaultCodespace, msg.HashLock)
+	}
+	if msg.TimeLock < MinTimeLock || msg.TimeLock > MaxTimeLock {
+		return ErrInvalidTimeLock(DefaultCodespace, msg.TimeLock)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCreateSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgClaimSwap completes a swap by revealing the secret hashing to its hash
+// lock, which sends the escrowed coins to the recipient of the swap. Any
+// account may claim a swap on behalf of the recipient.
+type MsgClaimSwap struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	SwapID cmn.HexBytes   `json:"swap_id" yaml:"swap_id"`
+	Secret cmn.HexBytes   `json:"secret" yaml:"secret"`
+}
+
+// NewMsgClaimSwap creates a new MsgClaimSwap object.
+func NewMsgClaimSwap(sender sdk.AccAddress, swapID, secret []byte) MsgClaimSwap {
+	return MsgClaimSwap{
+		Sender: sender,
+		SwapID: swapID,
+		Secret: secret,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgClaimSwap) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgClaimSwap) Type() string { return TypeMsgClaimSwap }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgClaimSwap) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if len(msg.SwapID) != HashLockLength {
+		return ErrInvalidSwap(DefaultCodespace, "invalid swap ID")
+	}
+	if len(msg.Secret) != SecretLength {
+		return ErrInvalidSecret(DefaultCodespace, msg.Secret)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgClaimSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgClaimSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+var (
+	senderAddr    = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipientAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	secret   = []byte("abcdefghijklmnopqrstuvwxyz012345")
+	hashLock = func() []byte { h := sha256.Sum256(secret); return h[:] }()
+)
+
+func TestMsgCreateSwapValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name      string
+		msg       MsgCreateSwap
+		expectErr bool
+	}{
+		{"valid msg", NewMsgCreateSwap(senderAddr, recipientAddr, amount, hashLock, MinTimeLock), false},
+		{"max time lock", NewMsgCreateSwap(senderAddr, recipientAddr, amount, hashLock, MaxTimeLock), false},
+		{"missing sender", NewMsgCreateSwap(nil, recipientAddr, amount, hashLock, MinTimeLock), true},
+		{"missing recipient", NewMsgCreateSwap(senderAddr, nil, amount, hashLock, MinTimeLock), true},
+		{"no amount", NewMsgCreateSwap(senderAddr, recipientAddr, sdk.Coins{}, hashLock, MinTimeLock), true},
+		{"invalid amount", NewMsgCreateSwap(senderAddr, recipientAddr, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, hashLock, MinTimeLock), true},
+		{"short hash lock", NewMsgCreateSwap(senderAddr, recipientAddr, amount, hashLock[1:], MinTimeLock), true},
+		{"time lock too short", NewMsgCreateSwap(senderAddr, recipientAddr, amount, hashLock, MinTimeLock-1), true},
+		{"time lock too long", NewMsgCreateSwap(senderAddr, recipientAddr, amount, hashLock, MaxTimeLock+1), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectErr {
+			require.NotNil(t, err, tc.name)
+		} else {
+			require.Nil(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgClaimSwapValidateBasic(t *testing.T) {
+	swapID := GetSwapID(hashLock, senderAddr)
+
+	require.Nil(t, NewMsgClaimSwap(recipientAddr, swapID, secret).ValidateBasic())
+	require.NotNil(t, NewMsgClaimSwap(nil, swapID, secret).ValidateBasic())
+	require.NotNil(t, NewMsgClaimSwap(recipientAddr, swapID[1:], secret).ValidateBasic())
+	require.NotNil(t, NewMsgClaimSwap(recipientAddr, swapID, secret[1:]).ValidateBasic())
+}
+
+func TestHashLockMatches(t *testing.T) {
+	require.True(t, HashLockMatches(hashLock, secret))
+	require.False(t, HashLockMatches(hashLock, []byte("bbcdefghijklmnopqrstuvwxyz012345")))
+	require.False(t, HashLockMatches(hashLock, secret[1:]))
+}
+
+func TestSwapJSON(t *testing.T) {
+	swap := NewSwap(senderAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), hashLock, 100)
+	swap.Status = StatusCompleted
+	swap.Secret = secret
+
+	bz, err := ModuleCdc.MarshalJSON(swap)
+	require.NoError(t, err)
+
+	var decoded Swap
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, swap, decoded)
+	require.Nil(t, decoded.ValidateBasic())
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	swap := NewSwap(senderAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), hashLock, 100)
+
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.NoError(t, NewGenesisState([]Swap{swap}).Validate())
+	require.Error(t, NewGenesisState([]Swap{swap, swap}).Validate())
+
+	completed := swap
+	completed.Status = StatusCompleted
+	require.Error(t, NewGenesisState([]Swap{completed}).Validate())
+
+	completed.Secret = secret
+	require.NoError(t, NewGenesisState([]Swap{completed}).Validate())
+}
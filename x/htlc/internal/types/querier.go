@@ -0,0 +1,35 @@
+package types
+
+import (
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Querier routes for the htlc module
+const (
+	QuerySwap             = "swap"
+	QuerySwapsBySender    = "swaps_by_sender"
+	QuerySwapsByRecipient = "swaps_by_recipient"
+)
+
+// QuerySwapParams defines the parameters necessary for querying a swap.
+type QuerySwapParams struct {
+	SwapID cmn.HexBytes `json:"swap_id" yaml:"swap_id"`
+}
+
+// NewQuerySwapParams creates a new QuerySwapParams object
+func NewQuerySwapParams(swapID []byte) QuerySwapParams {
+	return QuerySwapParams{SwapID: swapID}
+}
+
+// QuerySwapsByAddressParams defines the parameters necessary for querying the
+// swaps of a sender or a recipient.
+type QuerySwapsByAddressParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQuerySwapsByAddressParams creates a new QuerySwapsByAddressParams object
+func NewQuerySwapsByAddressParams(addr sdk.AccAddress) QuerySwapsByAddressParams {
+	return QuerySwapsByAddressParams{Address: addr}
+}
@@ -0,0 +1,198 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+const (
+	// HashLockLength is the length of a SHA-256 hash lock
+	HashLockLength = sha256.Size
+	// SecretLength is the length of the secret whose hash is the hash lock
+	SecretLength = 32
+
+	// MinTimeLock is the minimum number of blocks a swap can be locked for
+	MinTimeLock = 50
+	// MaxTimeLock is the maximum number of blocks a swap can be locked for
+	MaxTimeLock = 34560
+
+	// ClosedSwapRetention is the number of blocks completed and refunded swaps
+	// are kept in the store after their expire height
+	ClosedSwapRetention = 34560
+)
+
+// SwapStatus is the status of a swap
+type SwapStatus byte
+
+// Valid swap statuses
+const (
+	StatusOpen      SwapStatus = 0x01
+	StatusCompleted SwapStatus = 0x02
+	StatusRefunded  SwapStatus = 0x03
+)
+
+// SwapStatusFromString turns a string into a SwapStatus
+func SwapStatusFromString(str string) (SwapStatus, error) {
+	switch str {
+	case "Open":
+		return StatusOpen, nil
+	case "Completed":
+		return StatusCompleted, nil
+	case "Refunded":
+		return StatusRefunded, nil
+	default:
+		return SwapStatus(0xff), fmt.Errorf("'%s' is not a valid swap status", str)
+	}
+}
+
+// ValidSwapStatus returns true if the swap status is valid and false
+// otherwise.
+func ValidSwapStatus(status SwapStatus) bool {
+	return status == StatusOpen || status == StatusCompleted || status == StatusRefunded
+}
+
+// String implements the Stringer interface.
+func (status SwapStatus) String() string {
+	switch status {
+	case StatusOpen:
+		return "Open"
+	case StatusCompleted:
+		return "Completed"
+	case StatusRefunded:
+		return "Refunded"
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON marshals to JSON using string.
+func (status SwapStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON decodes from JSON using string.
+func (status *SwapStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	bz, err := SwapStatusFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*status = bz
+	return nil
+}
+
+// Swap is a hashed time-locked contract holding coins in escrow. The coins go
+// to the recipient if the secret hashing to the hash lock is revealed up to
+// the expire height, otherwise they are refunded to the sender.
+type Swap struct {
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	HashLock     cmn.HexBytes   `json:"hash_lock" yaml:"hash_lock"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+	Status       SwapStatus     `json:"status" yaml:"status"`
+	Secret       cmn.HexBytes   `json:"secret" yaml:"secret"` // set once the swap is completed
+}
+
+// NewSwap creates a new open Swap instance
+func NewSwap(sender, recipient sdk.AccAddress, amount sdk.Coins, hashLock []byte, expireHeight int64) Swap {
+	return Swap{
+		Sender:       sender,
+		Recipient:    recipient,
+		Amount:       amount,
+		HashLock:     hashLock,
+		ExpireHeight: expireHeight,
+		Status:       StatusOpen,
+	}
+}
+
+// ID returns the ID of the swap
+func (s Swap) ID() cmn.HexBytes {
+	return GetSwapID(s.HashLock, s.Sender)
+}
+
+// IsOpen returns true if the swap can still be claimed or refunded
+func (s Swap) IsOpen() bool {
+	return s.Status == StatusOpen
+}
+
+// PruneHeight returns the height at which the swap is deleted once it is
+// completed or refunded
+func (s Swap) PruneHeight() int64 {
+	return s.ExpireHeight + ClosedSwapRetention
+}
+
+// ValidateBasic performs a stateless validation of the swap fields
+func (s Swap) ValidateBasic() sdk.Error {
+	if s.Sender.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if s.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !s.Amount.IsValid() || s.Amount.Empty() {
+		return sdk.ErrInvalidCoins(s.Amount.String())
+	}
+	if len(s.HashLock) != HashLockLength {
+		return ErrInvalidHashLock(DefaultCodespace, s.HashLock)
+	}
+	if s.ExpireHeight <= 0 {
+		return ErrInvalidSwap(DefaultCodespace, fmt.Sprintf("invalid expire height %d", s.ExpireHeight))
+	}
+	if !ValidSwapStatus(s.Status) {
+		return ErrInvalidSwap(DefaultCodespace, fmt.Sprintf("invalid status %d", s.Status))
+	}
+	if s.Status == StatusCompleted && !HashLockMatches(s.HashLock, s.Secret) {
+		return ErrInvalidSecret(DefaultCodespace, s.Secret)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (s Swap) String() string {
+	return fmt.Sprintf(`Swap %s:
+  Sender:        %s
+  Recipient:     %s
+  Amount:        %s
+  Hash Lock:     %s
+  Expire Height: %d
+  Status:        %s
+  Secret:        %s`,
+		s.ID(), s.Sender, s.Recipient, s.Amount, s.HashLock, s.ExpireHeight, s.Status, s.Secret,
+	)
+}
+
+// Swaps defines a list of swaps.
+type Swaps []Swap
+
+// String implements the Stringer interface.
+func (ss Swaps) String() (out string) {
+	for _, s := range ss {
+		out += s.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// HashLockMatches returns true if the SHA-256 hash of the secret is the hash
+// lock
+func HashLockMatches(hashLock, secret []byte) bool {
+	if len(secret) != SecretLength {
+		return false
+	}
+
+	hash := sha256.Sum256(secret)
+	return bytes.Equal(hash[:], hashLock)
+}
@@ -57,8 +57,13 @@ Where proposal.json contains:
       "denom": "stake",
       "amount": "10000"
     }
-  ]
+  ],
+  "is_expedited": false
 }
+
+Urgent parameter changes can be submitted as expedited proposals by setting
+"is_expedited" to true. They have a shorter voting period but require a higher
+deposit and threshold.
 `,
 				version.ClientName,
 			),
@@ -76,6 +81,7 @@ Where proposal.json contains:
 			content := types.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
 
 			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			msg.IsExpedited = proposal.IsExpedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
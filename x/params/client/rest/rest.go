@@ -37,6 +37,7 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := params.NewParameterChangeProposal(req.Title, req.Description, req.Changes.ToParamChanges())
 
 		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		msg.IsExpedited = req.IsExpedited
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -45,6 +45,30 @@ var paramChangePool = []simParamChange{
 			return fmt.Sprintf("\"%d\"", simulation.ModuleParamSimulator[simulation.UnbondingTime](r).(time.Duration))
 		},
 	},
+	{
+		"staking",
+		"HistoricalEntries",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("%d", simulation.ModuleParamSimulator[simulation.HistoricalEntries](r).(uint16))
+		},
+	},
+	{
+		"staking",
+		"MaxVotingPowerFraction",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.MaxVotingPowerFraction](r).(sdk.Dec))
+		},
+	},
+	{
+		"staking",
+		"MinCommissionRate",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.MinCommissionRate](r).(sdk.Dec))
+		},
+	},
 	// slashing parameters
 	{
 		"slashing",
@@ -79,6 +103,23 @@ var paramChangePool = []simParamChange{
 			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.InflationRateChange](r).(sdk.Dec))
 		},
 	},
+	// distribution parameters
+	{
+		"distribution",
+		"autorestakeinterval",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%d\"", simulation.ModuleParamSimulator[simulation.AutoRestakeInterval](r).(int64))
+		},
+	},
+	{
+		"distribution",
+		"missedvoterewardsrecipient",
+		"",
+		func(r *rand.Rand) string {
+			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.MissedVoteRewardsRecipient](r).(string))
+		},
+	},
 	// gov parameters
 	{
 		"gov",
@@ -178,8 +178,8 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 }
 
 // Update stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or fails the validator registered for
+// the key. It also sets to the transient store to record change.
 func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -194,6 +194,10 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if err := attr.validateValue(dest); err != nil {
+		return err
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -238,6 +242,10 @@ func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey []byte, p
 		return err
 	}
 
+	if err := attr.validateValue(dest); err != nil {
+		return err
+	}
+
 	s.SetWithSubkey(ctx, key, subkey, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(concatkey, []byte{})
@@ -5,7 +5,8 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty       reflect.Type
+	validate func(value interface{}) error
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -68,6 +69,21 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
+// RegisterValidator registers a function validating the values of a registered
+// key. Parameter changes storing an invalid value are rejected.
+func (t KeyTable) RegisterValidator(key []byte, validate func(value interface{}) error) KeyTable {
+	keystr := string(key)
+	attr, ok := t.m[keystr]
+	if !ok {
+		panic("parameter not registered")
+	}
+
+	attr.validate = validate
+	t.m[keystr] = attr
+
+	return t
+}
+
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
@@ -76,6 +92,15 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	return t
 }
 
+// validateValue checks the value pointed to by ptr with the validator registered
+// for the key, if any.
+func (attr attribute) validateValue(ptr interface{}) error {
+	if attr.validate == nil {
+		return nil
+	}
+	return attr.validate(reflect.ValueOf(ptr).Elem().Interface())
+}
+
 func (t KeyTable) maxKeyLength() (res int) {
 	for k := range t.m {
 		l := len(k)
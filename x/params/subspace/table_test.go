@@ -33,3 +33,19 @@ func TestKeyTable(t *testing.T) {
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
 }
+
+func TestKeyTableValidator(t *testing.T) {
+	table := NewKeyTable([]byte("i"), int64(0))
+
+	require.Panics(t, func() { table.RegisterValidator([]byte("b"), func(interface{}) error { return nil }) })
+
+	var validated interface{}
+	table.RegisterValidator([]byte("i"), func(value interface{}) error {
+		validated = value
+		return nil
+	})
+
+	i := int64(5)
+	require.NoError(t, table.m["i"].validateValue(&i))
+	require.Equal(t, int64(5), validated)
+}
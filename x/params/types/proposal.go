@@ -13,8 +13,12 @@ const (
 	ProposalTypeChange = "ParameterChange"
 )
 
-// Assert ParameterChangeProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = ParameterChangeProposal{}
+// Assert ParameterChangeProposal implements govtypes.Content and
+// govtypes.SubtypedContent at compile-time
+var (
+	_ govtypes.Content         = ParameterChangeProposal{}
+	_ govtypes.SubtypedContent = ParameterChangeProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeChange)
@@ -45,6 +49,20 @@ func (pcp ParameterChangeProposal) ProposalRoute() string { return RouterKey }
 // ProposalType returns the type of a parameter change proposal.
 func (pcp ParameterChangeProposal) ProposalType() string { return ProposalTypeChange }
 
+// ProposalSubtypes returns the subspaces changed by a parameter change proposal,
+// which select the governance params applying to it.
+func (pcp ParameterChangeProposal) ProposalSubtypes() []string {
+	var subspaces []string
+	seen := make(map[string]bool)
+	for _, pc := range pcp.Changes {
+		if !seen[pc.Subspace] {
+			seen[pc.Subspace] = true
+			subspaces = append(subspaces, pc.Subspace)
+		}
+	}
+	return subspaces
+}
+
 // ValidateBasic validates the parameter change proposal
 func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
 	err := govtypes.ValidateAbstract(DefaultCodespace, pcp)
@@ -18,32 +18,40 @@ const (
 	maxTimePerBlock int64 = 10000
 
 	// Simulation parameter constants
-	SendEnabled              = "send_enabled"
-	MaxMemoChars             = "max_memo_characters"
-	TxSigLimit               = "tx_sig_limit"
-	TxSizeCostPerByte        = "tx_size_cost_per_byte"
-	SigVerifyCostED25519     = "sig_verify_cost_ed25519"
-	SigVerifyCostSECP256K1   = "sig_verify_cost_secp256k1"
-	DepositParamsMinDeposit  = "deposit_params_min_deposit"
-	VotingParamsVotingPeriod = "voting_params_voting_period"
-	TallyParamsQuorum        = "tally_params_quorum"
-	TallyParamsThreshold     = "tally_params_threshold"
-	TallyParamsVeto          = "tally_params_veto"
-	UnbondingTime            = "unbonding_time"
-	MaxValidators            = "max_validators"
-	SignedBlocksWindow       = "signed_blocks_window"
-	MinSignedPerWindow       = "min_signed_per_window"
-	DowntimeJailDuration     = "downtime_jail_duration"
-	SlashFractionDoubleSign  = "slash_fraction_double_sign"
-	SlashFractionDowntime    = "slash_fraction_downtime"
-	InflationRateChange      = "inflation_rate_change"
-	Inflation                = "inflation"
-	InflationMax             = "inflation_max"
-	InflationMin             = "inflation_min"
-	GoalBonded               = "goal_bonded"
-	CommunityTax             = "community_tax"
-	BaseProposerReward       = "base_proposer_reward"
-	BonusProposerReward      = "bonus_proposer_reward"
+	SendEnabled               = "send_enabled"
+	MaxMemoChars              = "max_memo_characters"
+	TxSigLimit                = "tx_sig_limit"
+	TxSizeCostPerByte         = "tx_size_cost_per_byte"
+	SigVerifyCostED25519      = "sig_verify_cost_ed25519"
+	SigVerifyCostSECP256K1    = "sig_verify_cost_secp256k1"
+	DepositParamsMinDeposit   = "deposit_params_min_deposit"
+	VotingParamsVotingPeriod  = "voting_params_voting_period"
+	TallyParamsQuorum         = "tally_params_quorum"
+	TallyParamsThreshold      = "tally_params_threshold"
+	TallyParamsVeto           = "tally_params_veto"
+	UnbondingTime             = "unbonding_time"
+	MaxValidators             = "max_validators"
+	HistoricalEntries         = "historical_entries"
+	MaxVotingPowerFraction    = "max_voting_power_fraction"
+	VotingPowerSoftLimit      = "voting_power_soft_limit"
+	MinCommissionRate         = "min_commission_rate"
+	SignedBlocksWindow        = "signed_blocks_window"
+	MinSignedPerWindow        = "min_signed_per_window"
+	DowntimeJailDuration      = "downtime_jail_duration"
+	SlashFractionDoubleSign   = "slash_fraction_double_sign"
+	SlashFractionDowntime     = "slash_fraction_downtime"
+	InflationRateChange       = "inflation_rate_change"
+	Inflation                 = "inflation"
+	InflationMax              = "inflation_max"
+	InflationMin              = "inflation_min"
+	GoalBonded                = "goal_bonded"
+	CommunityTax              = "community_tax"
+	BaseProposerReward        = "base_proposer_reward"
+	BonusProposerReward       = "bonus_proposer_reward"
+	AutoRestakeInterval       = "auto_restake_interval"
+	MaxAutoRestakeDelegations = "max_auto_restake_delegations"
+
+	MissedVoteRewardsRecipient = "missed_vote_rewards_recipient"
 )
 
 // TODO explain transitional matrix usage
@@ -107,6 +115,24 @@ var (
 		MaxValidators: func(r *rand.Rand) interface{} {
 			return uint16(r.Intn(250) + 1)
 		},
+		HistoricalEntries: func(r *rand.Rand) interface{} {
+			return uint16(r.Intn(100))
+		},
+		MaxVotingPowerFraction: func(r *rand.Rand) interface{} {
+			if r.Intn(2) == 0 {
+				return sdk.ZeroDec()
+			}
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 20, 100)), 2)
+		},
+		VotingPowerSoftLimit: func(r *rand.Rand) interface{} {
+			if r.Intn(2) == 0 {
+				return sdk.ZeroDec()
+			}
+			return sdk.NewDecWithPrec(int64(RandIntBetween(r, 20, 100)), 2)
+		},
+		MinCommissionRate: func(r *rand.Rand) interface{} {
+			return sdk.NewDecWithPrec(int64(r.Intn(10)), 2)
+		},
 		SignedBlocksWindow: func(r *rand.Rand) interface{} {
 			return int64(RandIntBetween(r, 10, 1000))
 		},
@@ -146,6 +172,16 @@ var (
 		BonusProposerReward: func(r *rand.Rand) interface{} {
 			return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 		},
+		AutoRestakeInterval: func(r *rand.Rand) interface{} {
+			return int64(r.Intn(10))
+		},
+		MaxAutoRestakeDelegations: func(r *rand.Rand) interface{} {
+			return uint32(r.Intn(10) + 1)
+		},
+		MissedVoteRewardsRecipient: func(r *rand.Rand) interface{} {
+			recipients := []string{"none", "community_pool", "signers"}
+			return recipients[r.Intn(len(recipients))]
+		},
 	}
 )
 
@@ -1,16 +1,15 @@
 package slashing
 
 import (
-	"fmt"
-
 	abci "github.com/tendermint/tendermint/abci/types"
-	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
-// BeginBlocker check for infraction evidence or downtime of validators
-// on every begin block
+// BeginBlocker check for downtime of validators on every begin block.
+//
+// NOTE: Evidence of double signing reported by Tendermint is handled by the
+// evidence module, which calls back into Keeper.HandleDoubleSign.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
@@ -18,16 +17,4 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
 		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
 	}
-
-	// Iterate through any newly discovered evidence of infraction
-	// Slash any validators (and since-unbonded stake within the unbonding period)
-	// who contributed to valid infractions
-	for _, evidence := range req.ByzantineValidators {
-		switch evidence.Type {
-		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			k.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
-		default:
-			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
-		}
-	}
 }
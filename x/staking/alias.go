@@ -31,7 +31,10 @@ const (
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultHistoricalEntries           = types.DefaultHistoricalEntries
 	NotBondedPoolName                  = types.NotBondedPoolName
+	TokenizeSharePoolName              = types.TokenizeSharePoolName
+	ShareDenomPrefix                   = types.ShareDenomPrefix
 	BondedPoolName                     = types.BondedPoolName
 	QueryValidators                    = types.QueryValidators
 	QueryValidator                     = types.QueryValidator
@@ -47,6 +50,7 @@ const (
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
+	QueryHistoricalInfo                = types.QueryHistoricalInfo
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -102,6 +106,7 @@ var (
 	ErrCommissionChangeRateNegative    = types.ErrCommissionChangeRateNegative
 	ErrCommissionChangeRateGTMaxRate   = types.ErrCommissionChangeRateGTMaxRate
 	ErrCommissionGTMaxChangeRate       = types.ErrCommissionGTMaxChangeRate
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
 	ErrSelfDelegationBelowMinimum      = types.ErrSelfDelegationBelowMinimum
 	ErrMinSelfDelegationInvalid        = types.ErrMinSelfDelegationInvalid
 	ErrMinSelfDelegationDecreased      = types.ErrMinSelfDelegationDecreased
@@ -119,6 +124,9 @@ var (
 	ErrBadSharesPercent                = types.ErrBadSharesPercent
 	ErrNotMature                       = types.ErrNotMature
 	ErrNoUnbondingDelegation           = types.ErrNoUnbondingDelegation
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrBadCancelUnbondingAmount        = types.ErrBadCancelUnbondingAmount
+	ErrBadCreationHeight               = types.ErrBadCreationHeight
 	ErrMaxUnbondingDelegationEntries   = types.ErrMaxUnbondingDelegationEntries
 	ErrBadRedelegationAddr             = types.ErrBadRedelegationAddr
 	ErrNoRedelegation                  = types.ErrNoRedelegation
@@ -131,6 +139,11 @@ var (
 	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
 	ErrMissingSignature                = types.ErrMissingSignature
+	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
+	ErrShareDenomTaken                 = types.ErrShareDenomTaken
+	ErrNoShareDenomValidator           = types.ErrNoShareDenomValidator
+	ErrTokenizeSharesVestingAccount    = types.ErrTokenizeSharesVestingAccount
+	ErrVotingPowerSoftLimitExceeded    = types.ErrVotingPowerSoftLimitExceeded
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -159,11 +172,23 @@ var (
 	GetREDsFromValSrcIndexKey          = types.GetREDsFromValSrcIndexKey
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
+	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	NewHistoricalInfo                  = types.NewHistoricalInfo
+	GetShareDenomKey                   = types.GetShareDenomKey
+	GetShareDenom                      = types.GetShareDenom
+	IsShareDenom                       = types.IsShareDenom
+	GetTokenizeShareHolderAddress      = types.GetTokenizeShareHolderAddress
+	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgTokenizeShares               = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares        = types.NewMsgRedeemTokensForShares
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -174,6 +199,7 @@ var (
 	NewQueryBondsParams                = types.NewQueryBondsParams
 	NewQueryRedelegationParams         = types.NewQueryRedelegationParams
 	NewQueryValidatorsParams           = types.NewQueryValidatorsParams
+	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
 	NewValidator                       = types.NewValidator
 	MustMarshalValidator               = types.MustMarshalValidator
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
@@ -195,51 +221,66 @@ var (
 	RedelegationByValDstIndexKey     = types.RedelegationByValDstIndexKey
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
+	HistoricalInfoKey                = types.HistoricalInfoKey
+	ShareDenomKey                    = types.ShareDenomKey
+	LastMinCommissionRateKey         = types.LastMinCommissionRateKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyHistoricalEntries             = types.KeyHistoricalEntries
+	DefaultMaxVotingPowerFraction    = types.DefaultMaxVotingPowerFraction
+	DefaultVotingPowerSoftLimit      = types.DefaultVotingPowerSoftLimit
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
+	KeyMaxVotingPowerFraction        = types.KeyMaxVotingPowerFraction
+	KeyVotingPowerSoftLimit          = types.KeyVotingPowerSoftLimit
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	CodeType                  = types.CodeType
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	CodeType                     = types.CodeType
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	HistoricalInfo               = types.HistoricalInfo
+	MultiStakingHooks            = types.MultiStakingHooks
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemTokensForShares     = types.MsgRedeemTokensForShares
+	Params                       = types.Params
+	Pool                         = types.Pool
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -38,6 +39,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdCancelUnbond(cdc),
+		GetCmdTokenizeShares(cdc),
+		GetCmdRedeemTokens(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -246,6 +250,117 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	}
 }
 
+// GetCmdCancelUnbond implements the cancel unbonding delegation command.
+func GetCmdCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-unbond [validator-addr] [amount] [creation-height]",
+		Short: "Cancel an unbonding delegation and delegate back to the validator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel an amount of an unbonding delegation entry and delegate it back to
+the validator it is unbonding from. The entry is identified by the height at
+which the unbonding was created; only its remaining balance can be cancelled.
+
+Example:
+$ %s tx staking cancel-unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 123456 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid creation height %s: %v", args[2], err)
+			}
+
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenizeShares implements the tokenize shares command.
+func GetCmdTokenizeShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount]",
+		Short: "Convert part of a delegation into transferable share tokens",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the delegator shares worth an amount of tokens of a delegation into
+share tokens of the validator, which can be sent like any other coin.
+
+Example:
+$ %s tx staking tokenize-share cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeemTokens implements the redeem tokens for shares command.
+func GetCmdRedeemTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens for a delegation to their validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem share tokens of a validator for a delegation owned by the sender,
+along with its part of the rewards earned by the tokenized shares.
+
+Example:
+$ %s tx staking redeem-tokens 100sh44c91e3b5e0cbd --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
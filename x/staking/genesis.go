@@ -59,6 +59,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	// raise genesis validators below the minimum commission rate
+	keeper.EnforceMinCommissionRate(ctx)
+
 	for _, delegation := range data.Delegations {
 		// Call the before-creation hook if not exported
 		if !data.Exported {
@@ -66,6 +69,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 		keeper.SetDelegation(ctx, delegation)
 
+		// restore the share denomination of tokenized delegations
+		if delegation.DelegatorAddress.Equals(types.GetTokenizeShareHolderAddress(delegation.ValidatorAddress)) {
+			keeper.SetShareDenomValidator(ctx, types.GetShareDenom(delegation.ValidatorAddress), delegation.ValidatorAddress)
+		}
+
 		// Call the after-modification hook if not exported
 		if !data.Exported {
 			keeper.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
@@ -87,6 +95,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 		}
 	}
 
+	for _, hi := range data.HistoricalInfos {
+		keeper.SetHistoricalInfo(ctx, hi.Header.Height, hi)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -168,16 +180,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		Delegations:          delegations,
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
+		HistoricalInfos:      keeper.GetAllHistoricalInfo(ctx),
 		Exported:             true,
 	}
 }
 
-// WriteValidators returns a slice of bonded genesis validators.
+// WriteValidators returns a slice of bonded genesis validators, with the
+// power last reported to Tendermint.
 func WriteValidators(ctx sdk.Context, keeper Keeper) (vals []tmtypes.GenesisValidator) {
 	keeper.IterateLastValidators(ctx, func(_ int64, validator exported.ValidatorI) (stop bool) {
 		vals = append(vals, tmtypes.GenesisValidator{
 			PubKey: validator.GetConsPubKey(),
-			Power:  validator.GetConsensusPower(),
+			Power:  keeper.GetLastValidatorPower(ctx, validator.GetOperator()),
 			Name:   validator.GetMoniker(),
 		})
 
@@ -198,6 +212,10 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateHistoricalInfos(data.HistoricalInfos)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -220,3 +238,17 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	}
 	return
 }
+
+func validateGenesisStateHistoricalInfos(infos []types.HistoricalInfo) error {
+	heights := make(map[int64]bool, len(infos))
+	for _, hi := range infos {
+		if err := hi.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid historical info in genesis state: %v", err)
+		}
+		if heights[hi.Header.Height] {
+			return fmt.Errorf("duplicate historical info in genesis state for height %d", hi.Header.Height)
+		}
+		heights[hi.Header.Height] = true
+	}
+	return nil
+}
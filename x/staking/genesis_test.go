@@ -126,6 +126,21 @@ func TestValidateGenesis(t *testing.T) {
 			(*data).Validators[0].Jailed = true
 			(*data).Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate historical infos
+		{"valid historical info", func(data *types.GenesisState) {
+			(*data).HistoricalInfos = []types.HistoricalInfo{
+				types.NewHistoricalInfo(abci.Header{Height: 5}, genValidators1),
+			}
+		}, false},
+		{"historical info with empty validator set", func(data *types.GenesisState) {
+			(*data).HistoricalInfos = []types.HistoricalInfo{
+				types.NewHistoricalInfo(abci.Header{Height: 5}, nil),
+			}
+		}, true},
+		{"duplicate historical info", func(data *types.GenesisState) {
+			hi := types.NewHistoricalInfo(abci.Header{Height: 5}, genValidators1)
+			(*data).HistoricalInfos = []types.HistoricalInfo{hi, hi}
+		}, true},
 	}
 
 	for _, tt := range tests {
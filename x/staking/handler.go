@@ -33,6 +33,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
 
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
+
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+
+		case types.MsgRedeemTokensForShares:
+			return handleMsgRedeemTokensForShares(ctx, msg, k)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -40,8 +49,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
+// BeginBlocker persists the current header and validator set as a historical
+// entry, pruning the entries that fall outside of the HistoricalEntries window
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.TrackHistoricalInfo(ctx)
+}
+
 // Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	// Raise the commission of validators below a newly raised minimum
+	// commission rate.
+	k.EnforceMinCommissionRate(ctx)
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -125,6 +144,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		}
 	}
 
+	if minRate := k.MinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		return ErrCommissionLTMinRate(k.Codespace(), minRate).Result()
+	}
+
 	validator := NewValidator(msg.ValidatorAddress, msg.PubKey, msg.Description)
 	commission := NewCommissionWithTime(
 		msg.Commission.Rate, msg.Commission.MaxRate,
@@ -233,8 +256,13 @@ func handleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k keeper.Keeper)
 		return ErrBadDenom(k.Codespace()).Result()
 	}
 
+	err := k.ValidateVotingPowerSoftLimit(ctx, validator, msg.Amount.Amount, sdk.Unbonded)
+	if err != nil {
+		return err.Result()
+	}
+
 	// NOTE: source funds are always unbonded
-	_, err := k.Delegate(ctx, msg.DelegatorAddress, msg.Amount.Amount, sdk.Unbonded, validator, true)
+	_, err = k.Delegate(ctx, msg.DelegatorAddress, msg.Amount.Amount, sdk.Unbonded, validator, true)
 	if err != nil {
 		return err.Result()
 	}
@@ -290,6 +318,46 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 	return sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}
 }
 
+func handleMsgCancelUnbondingDelegation(ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper) sdk.Result {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return ErrNoValidatorFound(k.Codespace()).Result()
+	}
+
+	// NOTE: unbonding funds are held by the not bonded pool
+	err := k.ValidateVotingPowerSoftLimit(ctx, validator, msg.Amount.Amount, sdk.Unbonding)
+	if err != nil {
+		return err.Result()
+	}
+
+	err = k.CancelUnbondingDelegation(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.CreationHeight, msg.Amount.Amount,
+	)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, fmt.Sprintf("%d", msg.CreationHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) sdk.Result {
 	shares, err := k.ValidateUnbondAmount(
 		ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount,
@@ -302,6 +370,20 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 		return ErrBadDenom(k.Codespace()).Result()
 	}
 
+	srcValidator, found := k.GetValidator(ctx, msg.ValidatorSrcAddress)
+	if !found {
+		return ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	dstValidator, found := k.GetValidator(ctx, msg.ValidatorDstAddress)
+	if !found {
+		return ErrBadRedelegationDst(k.Codespace()).Result()
+	}
+
+	err = k.ValidateVotingPowerSoftLimit(ctx, dstValidator, msg.Amount.Amount, srcValidator.GetStatus())
+	if err != nil {
+		return err.Result()
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.ValidatorDstAddress, shares,
 	)
@@ -327,3 +409,52 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}
 }
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) sdk.Result {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return ErrBadDenom(k.Codespace()).Result()
+	}
+
+	shareTokens, err := k.TokenizeShares(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRedeemTokensForShares(ctx sdk.Context, msg types.MsgRedeemTokensForShares, k keeper.Keeper) sdk.Result {
+	valAddr, err := k.RedeemTokensForShares(ctx, msg.DelegatorAddress, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemTokensForShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -1324,3 +1324,84 @@ func TestInvalidCoinDenom(t *testing.T) {
 	got = handleMsgBeginRedelegate(ctx, msgRedelegate, keeper)
 	require.True(t, got.IsOK())
 }
+
+func TestVotingPowerSoftLimit(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valA, valB, valC, del := sdk.ValAddress(keep.Addrs[0]), sdk.ValAddress(keep.Addrs[1]),
+		sdk.ValAddress(keep.Addrs[2]), keep.Addrs[3]
+
+	params := keeper.GetParams(ctx)
+	params.VotingPowerSoftLimit = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	// validator creation is not subject to the limit
+	valTokens := sdk.TokensFromConsensusPower(10)
+	for i, valAddr := range []sdk.ValAddress{valA, valB, valC} {
+		msgCreateValidator := NewTestMsgCreateValidator(valAddr, keep.PKs[i], valTokens)
+		got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+		require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	}
+	EndBlocker(ctx, keeper)
+
+	// 15 out of 35 bonded tokens is within the limit
+	msgDelegate := NewTestMsgDelegate(del, valA, sdk.TokensFromConsensusPower(5))
+	got := handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+
+	// 21 out of 41 bonded tokens exceeds the limit
+	msgDelegate = NewTestMsgDelegate(del, valA, sdk.TokensFromConsensusPower(6))
+	got = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.False(t, got.IsOK(), "expected delegation above the soft limit to fail")
+	require.Equal(t, CodeInvalidDelegation, got.Code)
+
+	// bonded tokens moved to B leave the total unchanged, 15 out of 35
+	params.VotingPowerSoftLimit = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
+	msgBeginRedelegate := NewMsgBeginRedelegate(del, valA, valB, redAmt)
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.False(t, got.IsOK(), "expected redelegation above the soft limit to fail")
+	require.Equal(t, CodeInvalidDelegation, got.Code)
+
+	// a zero limit disables the check
+	params.VotingPowerSoftLimit = sdk.ZeroDec()
+	keeper.SetParams(ctx, params)
+
+	got = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgBeginRedelegate")
+}
+
+func TestMinCommissionRate(t *testing.T) {
+	ctx, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	valTokens := sdk.TokensFromConsensusPower(10)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+
+	// creating a validator below the minimum fails
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(
+		validatorAddr, keep.PKs[0], valTokens, sdk.NewDecWithPrec(5, 2),
+	)
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "expected create-validator below the min commission rate to fail")
+	require.Equal(t, CodeInvalidValidator, got.Code)
+
+	msgCreateValidator = NewTestMsgCreateValidatorWithCommission(
+		validatorAddr, keep.PKs[0], valTokens, sdk.NewDecWithPrec(1, 1),
+	)
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+	EndBlocker(ctx, keeper)
+
+	// raising the minimum raises the commission at the next end block
+	params.MinCommissionRate = sdk.NewDecWithPrec(2, 1)
+	keeper.SetParams(ctx, params)
+	EndBlocker(ctx, keeper)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), validator.Commission.Rate)
+}
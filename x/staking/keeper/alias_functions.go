@@ -124,6 +124,29 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delAddr sdk.AccAddress,
 	}
 }
 
+// iterate through the delegations from a delegator to the validators after
+// the given one in address order, or to every validator if it is empty
+func (k Keeper) IterateDelegationsAfter(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	fn func(index int64, del exported.DelegationI) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	delegatorPrefixKey := types.GetDelegationsKey(delAddr)
+	start := delegatorPrefixKey
+	if !valAddr.Empty() {
+		start = append(types.GetDelegationKey(delAddr, valAddr), 0x00)
+	}
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(delegatorPrefixKey)) // smallest to largest
+	defer iterator.Close()
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		del := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
+		stop := fn(i, del)
+		if stop {
+			break
+		}
+		i++
+	}
+}
+
 // return all delegations used during genesis dump
 // TODO: remove this func, change all usage for iterate functionality
 func (k Keeper) GetAllSDKDelegations(ctx sdk.Context) (delegations []types.Delegation) {
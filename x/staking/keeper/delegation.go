@@ -267,6 +267,29 @@ func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context,
 	return matureUnbonds
 }
 
+// Removes a single unbonding delegation from the timeslice of the unbonding
+// queue at completionTime, deleting the timeslice once it is empty
+func (k Keeper) RemoveFromUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
+	completionTime time.Time) {
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) &&
+			dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // return a given amount of all the delegator redelegations
 func (k Keeper) GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	maxRetrieve uint16) (redelegations []types.Redelegation) {
@@ -533,6 +556,34 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.In
 	return newShares, nil
 }
 
+// ValidateVotingPowerSoftLimit returns an error if delegating bondAmt to the
+// validator would push its share of the bonded tokens above the
+// VotingPowerSoftLimit parameter. tokenSrc indicates the bond status of the
+// incoming funds, bonded funds are already part of the bonded tokens.
+func (k Keeper) ValidateVotingPowerSoftLimit(ctx sdk.Context, validator types.Validator,
+	bondAmt sdk.Int, tokenSrc sdk.BondStatus) sdk.Error {
+
+	limit := k.VotingPowerSoftLimit(ctx)
+	if !limit.IsPositive() || limit.GTE(sdk.OneDec()) {
+		return nil
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if tokenSrc != sdk.Bonded {
+		totalBonded = totalBonded.Add(bondAmt)
+	}
+	if !validator.IsBonded() {
+		totalBonded = totalBonded.Add(validator.Tokens)
+	}
+
+	validatorTokens := validator.Tokens.Add(bondAmt)
+	if validatorTokens.ToDec().GT(limit.MulInt(totalBonded)) {
+		return types.ErrVotingPowerSoftLimitExceeded(k.Codespace(), limit)
+	}
+
+	return nil
+}
+
 // unbond a particular delegation and perform associated store operations
 func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
 	shares sdk.Dec) (amount sdk.Int, err sdk.Error) {
@@ -696,6 +747,74 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress,
 	return nil
 }
 
+// CancelUnbondingDelegation cancels part or all of the pending unbonding
+// delegation entry created at creationHeight and delegates the cancelled
+// amount back to the validator it was unbonding from. Only the current
+// balance of the entry, which already accounts for any slashing that occurred
+// while unbonding, can be cancelled.
+func (k Keeper) CancelUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int) sdk.Error {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the tokens would immediately be subject to the validator's punishment,
+	// so do not allow moving them back while the validator is jailed
+	if validator.Jailed {
+		return types.ErrValidatorJailed(k.Codespace())
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation(k.Codespace())
+	}
+
+	ctxTime := ctx.BlockHeader().Time
+
+	// find the first pending entry created at the given height
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctxTime) {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return types.ErrNoUnbondingDelegationEntry(k.Codespace(), creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if !amount.IsPositive() || amount.GT(entry.Balance) {
+		return types.ErrBadCancelUnbondingAmount(k.Codespace(), entry.Balance)
+	}
+
+	// unbonding tokens are always held by the not bonded pool
+	_, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false)
+	if err != nil {
+		return err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.RemoveFromUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec) (
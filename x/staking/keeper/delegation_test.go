@@ -944,3 +944,94 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	err := notBondedPool.SetCoins(sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), startTokens)))
+	require.NoError(t, err)
+	keeper.supplyKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// create a bonded validator and a delegator to that validator
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, delegation)
+
+	blockHeight := int64(10)
+	blockTime := time.Unix(333, 0).UTC()
+	ctx = ctx.WithBlockHeight(blockHeight).WithBlockTime(blockTime)
+
+	unbondTokens := sdk.TokensFromConsensusPower(6)
+	completionTime, err := keeper.Undelegate(ctx, addrDels[0], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Equal(t, unbondTokens, keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx)))
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	// the entry must exist and the amount must be covered by its balance
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight+1, unbondTokens)
+	require.Error(t, err)
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, unbondTokens.AddRaw(1))
+	require.Error(t, err)
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], blockHeight, unbondTokens)
+	require.Error(t, err)
+
+	// slashing the unbonding delegation reduces the amount that can be cancelled
+	ubd, found := keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	slashed := keeper.slashUnbondingDelegation(ctx, ubd, blockHeight, sdk.NewDecWithPrec(5, 1))
+	remaining := unbondTokens.Sub(slashed)
+
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, unbondTokens)
+	require.Error(t, err)
+
+	// cancel part of the entry
+	cancelTokens := sdk.TokensFromConsensusPower(1)
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, cancelTokens)
+	require.NoError(t, err)
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, remaining.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+	require.Len(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	expTokens := startTokens.Sub(unbondTokens).Add(cancelTokens)
+	require.Equal(t, expTokens, validator.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, expTokens, validator.BondedTokens())
+	require.Equal(t, remaining.Sub(cancelTokens), keeper.GetNotBondedPool(ctx).GetCoins().AmountOf(keeper.BondDenom(ctx)))
+
+	// cancelling is not possible while the validator is jailed
+	keeper.jailValidator(ctx, validator)
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, remaining.Sub(cancelTokens))
+	require.Error(t, err)
+	keeper.unjailValidator(ctx, keeper.mustGetValidator(ctx, addrVals[0]))
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// cancel the rest of the entry, removing it from the store and the queue
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, remaining.Sub(cancelTokens))
+	require.NoError(t, err)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, keeper.GetUBDQueueTimeSlice(ctx, completionTime))
+	require.True(t, keeper.GetNotBondedPool(ctx).GetCoins().Empty())
+
+	// matured entries can no longer be cancelled
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], cancelTokens.ToDec())
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(completionTime)
+	err = keeper.CancelUnbondingDelegation(ctx, addrDels[0], addrVals[0], blockHeight, cancelTokens)
+	require.Error(t, err)
+}
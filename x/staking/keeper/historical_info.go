@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking/types"
+)
+
+// GetHistoricalInfo gets the historical info at a given height
+func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (hi types.HistoricalInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetHistoricalInfoKey(height))
+	if value == nil {
+		return hi, false
+	}
+
+	hi = types.MustUnmarshalHistoricalInfo(k.cdc, value)
+	return hi, true
+}
+
+// SetHistoricalInfo sets the historical info at a given height
+func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi types.HistoricalInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHistoricalInfoKey(height), types.MustMarshalHistoricalInfo(k.cdc, hi))
+}
+
+// DeleteHistoricalInfo deletes the historical info at a given height
+func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHistoricalInfoKey(height))
+}
+
+// IterateHistoricalInfo iterates over all stored historical info in
+// ascending height order
+func (k Keeper) IterateHistoricalInfo(ctx sdk.Context, fn func(hi types.HistoricalInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		hi := types.MustUnmarshalHistoricalInfo(k.cdc, iterator.Value())
+		if fn(hi) {
+			break
+		}
+	}
+}
+
+// GetAllHistoricalInfo returns all stored historical info
+func (k Keeper) GetAllHistoricalInfo(ctx sdk.Context) (infos []types.HistoricalInfo) {
+	k.IterateHistoricalInfo(ctx, func(hi types.HistoricalInfo) bool {
+		infos = append(infos, hi)
+		return false
+	})
+	return infos
+}
+
+// TrackHistoricalInfo saves the latest historical info and deletes the
+// entries that fall outside of the HistoricalEntries window. It is called at
+// the beginning of every block.
+func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
+	entryNum := int64(k.HistoricalEntries(ctx))
+
+	// Prune every entry at or below height - entryNum. Iterating from the
+	// start of the keyspace, rather than deleting a single height, ensures
+	// all stale entries are removed after HistoricalEntries is lowered.
+	pruneHeight := ctx.BlockHeight() - entryNum
+	if pruneHeight >= 0 {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator(types.HistoricalInfoKey,
+			sdk.InclusiveEndBytes(types.GetHistoricalInfoKey(pruneHeight)))
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	// historical info is not stored when HistoricalEntries is 0
+	if entryNum == 0 {
+		return
+	}
+
+	lastVals := k.GetLastValidators(ctx)
+	historicalEntry := types.NewHistoricalInfo(ctx.BlockHeader(), lastVals)
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), historicalEntry)
+}
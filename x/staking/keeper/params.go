@@ -37,19 +37,50 @@ func (k Keeper) MaxEntries(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// HistoricalEntries - Number of historical info entries
+// to persist in store
+func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint16) {
+	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
 	return
 }
 
+// MaxVotingPowerFraction - Maximum fraction of the total consensus power
+// reported for a single validator
+func (k Keeper) MaxVotingPowerFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxVotingPowerFraction, &res)
+	return
+}
+
+// VotingPowerSoftLimit - Maximum fraction of the bonded tokens a validator
+// may reach through new delegations
+func (k Keeper) VotingPowerSoftLimit(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyVotingPowerSoftLimit, &res)
+	return
+}
+
+// MinCommissionRate - Minimum commission rate charged by validators
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.UnbondingTime(ctx),
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
+		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MaxVotingPowerFraction(ctx),
+		k.VotingPowerSoftLimit(ctx),
+		k.MinCommissionRate(ctx),
 	)
 }
 
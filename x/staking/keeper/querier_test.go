@@ -551,3 +551,49 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
 	require.Equal(t, 0, len(ubDels))
 }
+
+func TestQueryHistoricalInfo(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _ := CreateTestInput(t, false, 10000)
+
+	// Create Validators
+	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8)}
+	var validators [2]types.Validator
+	for i, amt := range amts {
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
+		keeper.SetValidator(ctx, validators[i])
+		keeper.SetValidatorByPowerIndex(ctx, validators[i])
+	}
+
+	header := abci.Header{
+		ChainID: "HelloChain",
+		Height:  5,
+	}
+	hi := types.NewHistoricalInfo(header, validators[:])
+	keeper.SetHistoricalInfo(ctx, 5, hi)
+
+	queryHistoricalParams := types.NewQueryHistoricalInfoParams(4)
+	bz, errRes := cdc.MarshalJSON(queryHistoricalParams)
+	require.Nil(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/historicalInfo",
+		Data: bz,
+	}
+	res, err := queryHistoricalInfo(ctx, query, keeper)
+	require.NotNil(t, err, "Invalid query passed")
+	require.Nil(t, res, "Invalid query returned non-nil result")
+
+	queryHistoricalParams = types.NewQueryHistoricalInfoParams(5)
+	bz, errRes = cdc.MarshalJSON(queryHistoricalParams)
+	require.Nil(t, errRes)
+	query.Data = bz
+	res, err = queryHistoricalInfo(ctx, query, keeper)
+	require.Nil(t, err, "Valid query failed")
+	require.NotNil(t, res, "Valid query returned nil result")
+
+	var recv types.HistoricalInfo
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &recv))
+	require.Equal(t, hi.Header.Height, recv.Header.Height)
+	require.Equal(t, hi.ValSet.String(), recv.ValSet.String())
+}
@@ -28,11 +28,6 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
 	}
 
-	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := sdk.TokensFromConsensusPower(power)
-	slashAmountDec := amount.ToDec().Mul(slashFactor)
-	slashAmount := slashAmountDec.TruncateInt()
-
 	// ref https://github.com/hyperspeednetwork/hsnhub/issues/1348
 
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
@@ -54,6 +49,11 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	operatorAddress := validator.GetOperator()
 
+	// Amount of slashing = slash slashFactor * stake at time of infraction
+	amount := k.slashBaseTokens(ctx, validator, infractionHeight, power)
+	slashAmountDec := amount.ToDec().Mul(slashFactor)
+	slashAmount := slashAmountDec.TruncateInt()
+
 	// call the before-modification hook
 	k.BeforeValidatorModified(ctx, operatorAddress)
 
@@ -141,6 +141,41 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	return
 }
 
+// slashBaseTokens returns the stake of a validator at the infraction height
+// that the slash factor applies to. This is the power reported by Tendermint,
+// unless the validator was bonded above the MaxVotingPowerFraction cap, whose
+// reported power is clipped to the cap. The tokens of the validator at the
+// infraction height are used instead if the historical info is kept, whether
+// or not the validator is still bonded.
+//
+// Otherwise, the current tokens of a validator still capped are scaled by the
+// ratio of the reported to the current capped power. A validator which has
+// left the active set since, e.g. jailed before the evidence arrived, has no
+// capped power left and is slashed on its current tokens.
+func (k Keeper) slashBaseTokens(ctx sdk.Context, validator types.Validator, infractionHeight int64, power int64) sdk.Int {
+	amount := sdk.TokensFromConsensusPower(power)
+	if power >= validator.PotentialConsensusPower() {
+		return amount
+	}
+
+	if hi, found := k.GetHistoricalInfo(ctx, infractionHeight); found {
+		for _, val := range hi.ValSet {
+			if val.OperatorAddress.Equals(validator.OperatorAddress) {
+				return sdk.MaxInt(amount, val.Tokens)
+			}
+		}
+	}
+
+	lastPower := k.GetLastValidatorPower(ctx, validator.OperatorAddress)
+	if lastPower <= 0 {
+		return sdk.MaxInt(amount, validator.Tokens)
+	}
+	if lastPower >= validator.ConsensusPower() {
+		return amount
+	}
+	return sdk.MaxInt(amount, validator.Tokens.MulRaw(power).QuoRaw(lastPower))
+}
+
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
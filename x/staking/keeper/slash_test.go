@@ -550,3 +550,106 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests Slash of a validator whose reported power is capped
+func TestSlashCappedValidator(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	powers := []int64{200, 50, 50}
+	for i, power := range powers {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, _ = validator.AddTokensFromDel(sdk.TokensFromConsensusPower(power))
+		validator = TestingUpdateValidator(keeper, ctx, validator, false)
+		keeper.SetValidatorByConsAddr(ctx, validator)
+	}
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, int64(66), keeper.GetLastValidatorPower(ctx, addrVals[0]))
+
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	oldBondedPool := keeper.GetBondedPool(ctx)
+
+	// the validator is slashed on its tokens, not on the capped power
+	keeper.Slash(ctx, consAddr, ctx.BlockHeight(), 66, fraction)
+
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(100), validator.GetConsensusPower())
+
+	newBondedPool := keeper.GetBondedPool(ctx)
+	diffTokens := oldBondedPool.GetCoins().Sub(newBondedPool.GetCoins()).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(100), diffTokens)
+
+	// the historical tokens are used for past infractions when kept
+	header := abci.Header{Height: ctx.BlockHeight() + 1}
+	historical := validator
+	historical.Tokens = sdk.TokensFromConsensusPower(120)
+	keeper.SetHistoricalInfo(ctx, header.Height, types.NewHistoricalInfo(header, []types.Validator{historical}))
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	ctx = ctx.WithBlockHeight(header.Height + 1)
+
+	lastPower := keeper.GetLastValidatorPower(ctx, addrVals[0])
+	require.True(t, lastPower < validator.GetConsensusPower())
+
+	oldBondedPool = keeper.GetBondedPool(ctx)
+	keeper.Slash(ctx, consAddr, header.Height, lastPower, fraction)
+
+	newBondedPool = keeper.GetBondedPool(ctx)
+	diffTokens = oldBondedPool.GetCoins().Sub(newBondedPool.GetCoins()).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(60), diffTokens)
+}
+
+func TestSlashJailedCappedValidator(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	powers := []int64{200, 50, 50}
+	for i, power := range powers {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		validator, _ = validator.AddTokensFromDel(sdk.TokensFromConsensusPower(power))
+		validator = TestingUpdateValidator(keeper, ctx, validator, false)
+		keeper.SetValidatorByConsAddr(ctx, validator)
+	}
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, int64(66), keeper.GetLastValidatorPower(ctx, addrVals[0]))
+
+	// the validator is jailed and leaves the active set before the evidence
+	// of an infraction committed while capped arrives
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	infractionHeight := ctx.BlockHeight()
+	validator, found := keeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	historical := validator
+	historical.Tokens = sdk.TokensFromConsensusPower(120)
+	header := abci.Header{Height: infractionHeight}
+	keeper.SetHistoricalInfo(ctx, infractionHeight, types.NewHistoricalInfo(header, []types.Validator{historical}))
+
+	keeper.Jail(ctx, consAddr)
+	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	ctx = ctx.WithBlockHeight(infractionHeight + 2)
+	require.Equal(t, int64(0), keeper.GetLastValidatorPower(ctx, addrVals[0]))
+
+	// the historical tokens are slashed, not the capped power
+	fraction := sdk.NewDecWithPrec(5, 1)
+	oldNotBondedPool := keeper.GetNotBondedPool(ctx)
+	keeper.Slash(ctx, consAddr, infractionHeight, 66, fraction)
+
+	newNotBondedPool := keeper.GetNotBondedPool(ctx)
+	diffTokens := oldNotBondedPool.GetCoins().Sub(newNotBondedPool.GetCoins()).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(60), diffTokens)
+
+	// without historical info, the current tokens are slashed
+	oldNotBondedPool = newNotBondedPool
+	keeper.Slash(ctx, consAddr, infractionHeight+1, 66, fraction)
+
+	newNotBondedPool = keeper.GetNotBondedPool(ctx)
+	diffTokens = oldNotBondedPool.GetCoins().Sub(newNotBondedPool.GetCoins()).AmountOf(keeper.BondDenom(ctx))
+	require.Equal(t, sdk.TokensFromConsensusPower(70), diffTokens)
+}
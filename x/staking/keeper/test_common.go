@@ -133,9 +133,10 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	)
 
 	maccPerms := map[string][]string{
-		auth.FeeCollectorName:   nil,
-		types.NotBondedPoolName: {supply.Burner, supply.Staking},
-		types.BondedPoolName:    {supply.Burner, supply.Staking},
+		auth.FeeCollectorName:       nil,
+		types.NotBondedPoolName:     {supply.Burner, supply.Staking},
+		types.BondedPoolName:        {supply.Burner, supply.Staking},
+		types.TokenizeSharePoolName: {supply.Minter, supply.Burner},
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bk, maccPerms)
 
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	authexported "github.com/hyperspeednetwork/hsnhub/x/auth/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/staking/types"
+)
+
+// GetShareDenomValidator returns the validator whose shares are tokenized
+// under the given denomination
+func (k Keeper) GetShareDenomValidator(ctx sdk.Context, denom string) (valAddr sdk.ValAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetShareDenomKey(denom))
+	if bz == nil {
+		return valAddr, false
+	}
+	return sdk.ValAddress(bz), true
+}
+
+// SetShareDenomValidator records the validator of a share denomination. The
+// record is never removed, so that a denomination always maps to the same
+// validator.
+func (k Keeper) SetShareDenomValidator(ctx sdk.Context, denom string, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetShareDenomKey(denom), valAddr.Bytes())
+}
+
+// TokenizeShares moves the delegator shares worth the given amount of tokens
+// from the delegation to the share holder delegation of the validator, and
+// mints share tokens to the delegator. The validator itself, and thus its
+// voting power, is left unchanged.
+//
+// The first share tokens of a validator are minted one per share. Afterwards
+// the share tokens are minted at the rate of the share holder delegation
+// shares to the share token supply, which grows as the rewards of the share
+// holder delegation are restaked.
+func (k Keeper) TokenizeShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Int) (shareTokens sdk.Coin, err sdk.Error) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareTokens, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	// the delegations of vesting accounts are tracked on the account, share
+	// tokens would allow to transfer the vesting coins
+	if _, ok := k.supplyKeeper.GetAccount(ctx, delAddr).(authexported.VestingAccount); ok {
+		return shareTokens, types.ErrTokenizeSharesVestingAccount(k.Codespace())
+	}
+
+	// the rewards earned so far belong to the share tokens already minted
+	k.restakeTokenizeShareRewards(ctx, validator)
+	validator, _ = k.GetValidator(ctx, valAddr)
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return shareTokens, err
+	}
+
+	// only whole shares can be tokenized
+	sharesAmount := shares.TruncateInt()
+	if !sharesAmount.IsPositive() {
+		return shareTokens, types.ErrBadSharesAmount(k.Codespace())
+	}
+	shares = sharesAmount.ToDec()
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return shareTokens, types.ErrNoDelegation(k.Codespace())
+	}
+
+	// the operator cannot tokenize its self delegation below the minimum
+	if delAddr.Equals(validator.OperatorAddress) &&
+		validator.TokensFromShares(delegation.Shares.Sub(shares)).TruncateInt().LT(validator.MinSelfDelegation) {
+		return shareTokens, types.ErrSelfDelegationBelowMinimum(k.Codespace())
+	}
+
+	// the denomination only carries part of the hash of the validator address
+	denom := types.GetShareDenom(valAddr)
+	if denomVal, found := k.GetShareDenomValidator(ctx, denom); found && !denomVal.Equals(valAddr) {
+		return shareTokens, types.ErrShareDenomTaken(k.Codespace(), denom)
+	}
+
+	holderAddr := types.GetTokenizeShareHolderAddress(valAddr)
+	supply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+
+	tokensAmount := sharesAmount
+	if holderDelegation, found := k.GetDelegation(ctx, holderAddr, valAddr); found && supply.IsPositive() {
+		tokensAmount = shares.MulInt(supply).Quo(holderDelegation.Shares).TruncateInt()
+	}
+	if !tokensAmount.IsPositive() {
+		return shareTokens, types.ErrBadSharesAmount(k.Codespace())
+	}
+
+	k.removeDelegationShares(ctx, delegation, shares)
+	k.addDelegationShares(ctx, holderAddr, valAddr, shares)
+	k.SetShareDenomValidator(ctx, denom, valAddr)
+
+	shareTokens = sdk.NewCoin(denom, tokensAmount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizeSharePoolName, sdk.NewCoins(shareTokens)); err != nil {
+		return shareTokens, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharePoolName, delAddr, sdk.NewCoins(shareTokens))
+	if err != nil {
+		return shareTokens, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns the given share tokens and moves their part of
+// the share holder delegation shares of the validator to a delegation of the
+// redeemer. The rewards of the share holder delegation are restaked first, so
+// that the redeemed shares include the rewards earned while tokenized.
+//
+// As the shares are moved unchanged, slashes of the validator while the
+// shares were tokenized reduce the tokens the redeemed delegation is worth.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress,
+	shareTokens sdk.Coin) (valAddr sdk.ValAddress, err sdk.Error) {
+
+	valAddr, found := k.GetShareDenomValidator(ctx, shareTokens.Denom)
+	if !found {
+		return valAddr, types.ErrNoShareDenomValidator(k.Codespace(), shareTokens.Denom)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return valAddr, types.ErrNoValidatorFound(k.Codespace())
+	}
+
+	holderAddr := types.GetTokenizeShareHolderAddress(valAddr)
+	holderDelegation, found := k.GetDelegation(ctx, holderAddr, valAddr)
+	if !found {
+		return valAddr, types.ErrNoDelegation(k.Codespace())
+	}
+
+	k.restakeTokenizeShareRewards(ctx, validator)
+	holderDelegation, _ = k.GetDelegation(ctx, holderAddr, valAddr)
+
+	supply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(shareTokens.Denom)
+	if shareTokens.Amount.GT(supply) {
+		return valAddr, types.ErrNotEnoughDelegationShares(k.Codespace(), supply.String())
+	}
+
+	// the last share tokens redeemed take all the remaining shares
+	shares := holderDelegation.Shares
+	if shareTokens.Amount.LT(supply) {
+		shares = shares.MulInt(shareTokens.Amount).QuoInt(supply)
+	}
+	if !shares.IsPositive() {
+		return valAddr, types.ErrBadSharesAmount(k.Codespace())
+	}
+
+	coins := sdk.NewCoins(shareTokens)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizeSharePoolName, coins); err != nil {
+		return valAddr, err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.TokenizeSharePoolName, coins); err != nil {
+		return valAddr, err
+	}
+
+	k.removeDelegationShares(ctx, holderDelegation, shares)
+
+	// the coins the share holder could not restake go to the last redeemer
+	if shares.Equal(holderDelegation.Shares) {
+		if holder := k.supplyKeeper.GetAccount(ctx, holderAddr); holder != nil && !holder.GetCoins().IsZero() {
+			holderCoins := holder.GetCoins()
+			err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, holderAddr, types.TokenizeSharePoolName, holderCoins)
+			if err != nil {
+				return valAddr, err
+			}
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharePoolName, delAddr, holderCoins)
+			if err != nil {
+				return valAddr, err
+			}
+		}
+	}
+
+	k.addDelegationShares(ctx, delAddr, valAddr, shares)
+
+	return valAddr, nil
+}
+
+// restakeTokenizeShareRewards withdraws the rewards of the share holder
+// delegation of a validator and delegates their bond denomination part back
+// to the validator. The restaked rewards raise the shares each share token
+// is redeemed for, so they accrue to the share tokens minted so far only.
+// A failed delegation leaves the rewards with the share holder.
+func (k Keeper) restakeTokenizeShareRewards(ctx sdk.Context, validator types.Validator) {
+	valAddr := validator.OperatorAddress
+	holderAddr := types.GetTokenizeShareHolderAddress(valAddr)
+	if _, found := k.GetDelegation(ctx, holderAddr, valAddr); !found {
+		return
+	}
+
+	// the hooks withdraw the outstanding rewards to the share holder
+	k.BeforeDelegationSharesModified(ctx, holderAddr, valAddr)
+	k.AfterDelegationModified(ctx, holderAddr, valAddr)
+
+	holder := k.supplyKeeper.GetAccount(ctx, holderAddr)
+	if holder == nil {
+		return
+	}
+	amount := holder.GetCoins().AmountOf(k.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ValidateVotingPowerSoftLimit(cacheCtx, validator, amount, sdk.Unbonded); err != nil {
+		return
+	}
+	if _, err := k.Delegate(cacheCtx, holderAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		return
+	}
+	writeCache()
+}
+
+// removeDelegationShares removes shares from a delegation without changing
+// the validator, calling the delegation hooks
+func (k Keeper) removeDelegationShares(ctx sdk.Context, delegation types.Delegation, shares sdk.Dec) {
+	k.BeforeDelegationSharesModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delegation)
+		return
+	}
+
+	k.SetDelegation(ctx, delegation)
+	k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+}
+
+// addDelegationShares adds shares to a delegation, creating it if needed,
+// without changing the validator, calling the delegation hooks
+func (k Keeper) addDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	} else {
+		delegation = types.NewDelegation(delAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, delAddr, valAddr)
+	}
+
+	delegation.Shares = delegation.Shares.Add(shares)
+	k.SetDelegation(ctx, delegation)
+	k.AfterDelegationModified(ctx, delAddr, valAddr)
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	ctx, accKeeper, keeper, _ := CreateTestInput(t, false, 10)
+
+	// create a bonded validator and a delegator to that validator
+	startTokens := sdk.TokensFromConsensusPower(10)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, delegation)
+
+	denom := types.GetShareDenom(addrVals[0])
+	holderAddr := types.GetTokenizeShareHolderAddress(addrVals[0])
+
+	// cannot tokenize more than the delegation
+	_, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], startTokens.AddRaw(1))
+	require.Error(t, err)
+	_, err = keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.OneInt())
+	require.Error(t, err)
+
+	tokenizeTokens := sdk.TokensFromConsensusPower(4)
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], tokenizeTokens)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(denom, tokenizeTokens), shareTokens)
+	require.Equal(t, tokenizeTokens, accKeeper.GetAccount(ctx, addrDels[0]).GetCoins().AmountOf(denom))
+
+	// the shares are moved to the holder, the validator is unchanged
+	delegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(tokenizeTokens).ToDec(), delegation.Shares)
+
+	holderDelegation, found := keeper.GetDelegation(ctx, holderAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), holderDelegation.Shares)
+
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares, validator.DelegatorShares)
+
+	valAddr, found := keeper.GetShareDenomValidator(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, addrVals[0], valAddr)
+
+	// halve the validator tokens and send the holder coins it cannot restake
+	validator = keeper.RemoveValidatorTokens(ctx, validator, startTokens.QuoRaw(2))
+	holder := accKeeper.NewAccountWithAddress(ctx, holderAddr)
+	require.NoError(t, holder.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("reward", 100))))
+	accKeeper.SetAccount(ctx, holder)
+
+	// redeem a quarter of the share tokens
+	redeemTokens := sdk.NewCoin(denom, tokenizeTokens.QuoRaw(4))
+	valAddr, err = keeper.RedeemTokensForShares(ctx, addrDels[0], redeemTokens)
+	require.NoError(t, err)
+	require.Equal(t, addrVals[0], valAddr)
+
+	coins := accKeeper.GetAccount(ctx, addrDels[0]).GetCoins()
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens.Amount), coins.AmountOf(denom))
+	require.True(t, coins.AmountOf("reward").IsZero())
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Sub(tokenizeTokens).Add(redeemTokens.Amount).ToDec(), delegation.Shares)
+
+	// the slash reduced the value of the redeemed shares
+	validator, found = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, redeemTokens.Amount.QuoRaw(2), validator.TokensFromShares(redeemTokens.Amount.ToDec()).TruncateInt())
+
+	// cannot redeem more than the tokenized shares or unknown denominations
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(denom, tokenizeTokens))
+	require.Error(t, err)
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewInt64Coin("shunknown", 1))
+	require.Error(t, err)
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(types.GetShareDenom(addrVals[1]), sdk.OneInt()))
+	require.Error(t, err)
+
+	// redeeming the remaining share tokens removes the holder delegation and
+	// pays the coins left to the holder
+	redeemTokens = sdk.NewCoin(denom, tokenizeTokens.Sub(redeemTokens.Amount))
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], redeemTokens)
+	require.NoError(t, err)
+
+	coins = accKeeper.GetAccount(ctx, addrDels[0]).GetCoins()
+	require.True(t, coins.AmountOf(denom).IsZero())
+	require.Equal(t, sdk.NewInt(100), coins.AmountOf("reward"))
+
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares, delegation.Shares)
+
+	_, found = keeper.GetDelegation(ctx, holderAddr, addrVals[0])
+	require.False(t, found)
+
+	// the denomination keeps mapping to the validator
+	valAddr, found = keeper.GetShareDenomValidator(ctx, denom)
+	require.True(t, found)
+	require.Equal(t, addrVals[0], valAddr)
+
+	// a denomination recorded for another validator cannot be reused
+	keeper.SetShareDenomValidator(ctx, denom, addrVals[1])
+	_, err = keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], tokenizeTokens)
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+}
+
+func TestTokenizeSharesRestakesRewards(t *testing.T) {
+	ctx, accKeeper, keeper, _ := CreateTestInput(t, false, 20)
+
+	startTokens := sdk.TokensFromConsensusPower(10)
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	_, err := keeper.Delegate(ctx, addrDels[1], startTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	denom := types.GetShareDenom(addrVals[0])
+	holderAddr := types.GetTokenizeShareHolderAddress(addrVals[0])
+
+	// the first share tokens are minted one per share
+	shareTokens, err := keeper.TokenizeShares(ctx, addrDels[0], addrVals[0], sdk.TokensFromConsensusPower(4))
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(4), shareTokens.Amount)
+
+	// the holder is paid rewards, restaked before the next tokenization
+	rewards := sdk.TokensFromConsensusPower(2)
+	holder := accKeeper.NewAccountWithAddress(ctx, holderAddr)
+	require.NoError(t, holder.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))))
+	accKeeper.SetAccount(ctx, holder)
+
+	shareTokens, err = keeper.TokenizeShares(ctx, addrDels[1], addrVals[0], sdk.TokensFromConsensusPower(6))
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(4), shareTokens.Amount)
+	require.True(t, accKeeper.GetAccount(ctx, holderAddr).GetCoins().IsZero())
+
+	holderDelegation, found := keeper.GetDelegation(ctx, holderAddr, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(12).ToDec(), holderDelegation.Shares)
+
+	// redeeming right away does not take any of the earlier rewards
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[1], shareTokens)
+	require.NoError(t, err)
+	delegation, found := keeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.ToDec(), delegation.Shares)
+
+	// the first share tokens are redeemed along with the restaked rewards
+	_, err = keeper.RedeemTokensForShares(ctx, addrDels[0], sdk.NewCoin(denom, sdk.TokensFromConsensusPower(4)))
+	require.NoError(t, err)
+	delegation, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, startTokens.Add(rewards).ToDec(), delegation.Shares)
+
+	_, found = keeper.GetDelegation(ctx, holderAddr, addrVals[0])
+	require.False(t, found)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -35,6 +36,9 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Determine the power cap of the new validator set, if any.
+	powerCap := k.validatorSetPowerCap(ctx, maxValidators)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.ValidatorsByPowerIndexKey)
 	defer iterator.Close()
@@ -76,13 +80,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		copy(valAddrBytes[:], valAddr[:])
 		oldPowerBytes, found := last[valAddrBytes]
 
-		// calculate the new power bytes
+		// calculate the new power bytes, clipping the power to the cap
 		newPower := validator.ConsensusPower()
+		if newPower > powerCap {
+			newPower = powerCap
+		}
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(newPower)
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+			update := validator.ABCIValidatorUpdate()
+			update.Power = newPower
+			updates = append(updates, update)
 
 			// set validator power on lookup index
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
@@ -140,6 +149,74 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates
 }
 
+// validatorSetPowerCap returns the maximum consensus power reported for a
+// single validator of the validator set that is about to be bonded, as
+// determined by the MaxVotingPowerFraction parameter.
+func (k Keeper) validatorSetPowerCap(ctx sdk.Context, maxValidators uint16) int64 {
+	maxFraction := k.MaxVotingPowerFraction(ctx)
+	if !maxFraction.IsPositive() || maxFraction.GTE(sdk.OneDec()) {
+		return math.MaxInt64
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ValidatorsByPowerIndexKey)
+	defer iterator.Close()
+
+	var powers []int64
+	for ; iterator.Valid() && len(powers) < int(maxValidators); iterator.Next() {
+		validator := k.mustGetValidator(ctx, sdk.ValAddress(iterator.Value()))
+
+		power := validator.PotentialConsensusPower()
+		if power == 0 {
+			break
+		}
+		powers = append(powers, power)
+	}
+
+	return powerCap(powers, maxFraction)
+}
+
+// powerCap computes the largest power C such that, once every power above C
+// is clipped to C, no power exceeds maxFraction of the clipped total. Powers
+// must be positive and sorted in descending order.
+//
+// With the k largest powers clipped, the cap satisfies C = f * (k*C + rest),
+// where rest is the sum of the remaining powers, so C = f * rest / (1 - k*f).
+// The smallest k for which the largest unclipped power stays below C gives
+// the cap. If no such k exists, which is the case when the set has fewer than
+// 1/f validators, every power is clipped to the smallest one.
+func powerCap(powers []int64, maxFraction sdk.Dec) int64 {
+	if len(powers) == 0 {
+		return math.MaxInt64
+	}
+
+	sort.Slice(powers, func(i, j int) bool { return powers[i] > powers[j] })
+
+	rest := int64(0)
+	for _, power := range powers {
+		rest += power
+	}
+
+	for k := 0; k < len(powers); k++ {
+		denom := sdk.OneDec().Sub(maxFraction.MulInt64(int64(k)))
+		if !denom.IsPositive() {
+			break
+		}
+
+		powerCap := maxFraction.MulInt64(rest).Quo(denom)
+		if powerCap.GTE(sdk.NewDec(powers[k])) {
+			if k == 0 {
+				return math.MaxInt64
+			}
+			return powerCap.TruncateInt64()
+		}
+
+		rest -= powers[k]
+	}
+
+	return powers[len(powers)-1]
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) types.Validator {
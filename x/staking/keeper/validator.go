@@ -165,12 +165,89 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, types.ErrCommissionLTMinRate(k.Codespace(), minRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// get the minimum commission rate last enforced on the validators
+func (k Keeper) GetLastMinCommissionRate(ctx sdk.Context) (minRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastMinCommissionRateKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &minRate)
+	return minRate
+}
+
+// set the minimum commission rate last enforced on the validators
+func (k Keeper) SetLastMinCommissionRate(ctx sdk.Context, minRate sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(minRate)
+	store.Set(types.LastMinCommissionRateKey, bz)
+}
+
+// delete the minimum commission rate last enforced on the validators
+func (k Keeper) DeleteLastMinCommissionRate(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LastMinCommissionRateKey)
+}
+
+// EnforceMinCommissionRate raises the commission rate of every validator below
+// the MinCommissionRate parameter up to the minimum. As commission rates are
+// validated against the parameter on creation and edit, validators are only
+// visited when the parameter was raised since it was last enforced.
+//
+// The rate is changed regardless of MaxChangeRate and without resetting the
+// commission update time, an event is emitted for every validator changed.
+func (k Keeper) EnforceMinCommissionRate(ctx sdk.Context) {
+	minRate := k.MinCommissionRate(ctx)
+	lastMinRate := k.GetLastMinCommissionRate(ctx)
+	if minRate.Equal(lastMinRate) {
+		return
+	}
+
+	if !minRate.IsPositive() {
+		k.DeleteLastMinCommissionRate(ctx)
+		return
+	}
+
+	k.SetLastMinCommissionRate(ctx, minRate)
+	if minRate.LT(lastMinRate) {
+		return
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		k.SetValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMinCommissionRate,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, minRate.String()),
+			),
+		)
+	}
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
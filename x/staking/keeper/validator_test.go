@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -1084,3 +1085,118 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestPowerCap(t *testing.T) {
+	tests := []struct {
+		powers      []int64
+		maxFraction sdk.Dec
+		expected    int64
+	}{
+		{nil, sdk.NewDecWithPrec(5, 1), math.MaxInt64},
+		{[]int64{10, 10, 10}, sdk.NewDecWithPrec(5, 1), math.MaxInt64},
+		{[]int64{100, 50, 50}, sdk.NewDecWithPrec(4, 1), 66},
+		{[]int64{100, 10, 10, 10}, sdk.NewDecWithPrec(25, 2), 10},
+		{[]int64{100, 90, 10}, sdk.NewDecWithPrec(4, 1), 20},
+		{[]int64{100, 50}, sdk.NewDecWithPrec(4, 1), 50},
+	}
+
+	for i, tc := range tests {
+		require.Equal(t, tc.expected, powerCap(tc.powers, tc.maxFraction), "test case %d", i)
+	}
+}
+
+func TestApplyAndReturnValidatorSetUpdatesPowerCap(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.MaxVotingPowerFraction = sdk.NewDecWithPrec(4, 1)
+	keeper.SetParams(ctx, params)
+
+	powers := []int64{100, 50, 50}
+	var validators [3]types.Validator
+	for i, power := range powers {
+		validators[i] = types.NewValidator(sdk.ValAddress(Addrs[i]), PKs[i], types.Description{})
+		tokens := sdk.TokensFromConsensusPower(power)
+		validators[i], _ = validators[i].AddTokensFromDel(tokens)
+		validators[i] = TestingUpdateValidator(keeper, ctx, validators[i], false)
+	}
+
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+
+	// the largest validator is reported with the capped power
+	expected := validators[0].ABCIValidatorUpdate()
+	expected.Power = 66
+	require.Equal(t, expected, updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[2])
+	require.Equal(t, int64(66), keeper.GetLastValidatorPower(ctx, validators[0].OperatorAddress))
+
+	// the tokens of the validator are not affected by the cap
+	validator, found := keeper.GetValidator(ctx, validators[0].OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, int64(100), validator.GetConsensusPower())
+
+	// removing the cap reports the full power again
+	params.MaxVotingPowerFraction = sdk.ZeroDec()
+	keeper.SetParams(ctx, params)
+
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
+}
+
+func TestEnforceMinCommissionRate(t *testing.T) {
+	ctx, _, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
+
+	commission1 := types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2))
+	commission2 := types.NewCommission(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+
+	val1 := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	val2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	val1, _ = val1.SetInitialCommission(commission1)
+	val2, _ = val2.SetInitialCommission(commission2)
+	keeper.SetValidator(ctx, val1)
+	keeper.SetValidator(ctx, val2)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	keeper.SetParams(ctx, params)
+
+	// new commission rates below the minimum are rejected
+	_, err := keeper.UpdateValidatorCommission(ctx, val2, sdk.NewDecWithPrec(4, 2))
+	require.Error(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.EnforceMinCommissionRate(ctx)
+	require.Equal(t, 1, len(ctx.EventManager().Events()))
+	require.Equal(t, minRate, keeper.GetLastMinCommissionRate(ctx))
+
+	// the rate and, if needed, the max rate are raised to the minimum
+	val1, found := keeper.GetValidator(ctx, val1.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, minRate, val1.Commission.Rate)
+	require.Equal(t, minRate, val1.Commission.MaxRate)
+	require.Equal(t, commission1.UpdateTime, val1.Commission.UpdateTime)
+
+	val2, found = keeper.GetValidator(ctx, val2.OperatorAddress)
+	require.True(t, found)
+	require.True(t, commission2.Equal(val2.Commission))
+
+	// validators are not visited again while the minimum is unchanged
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.EnforceMinCommissionRate(ctx)
+	require.Equal(t, 0, len(ctx.EventManager().Events()))
+
+	// removing the minimum leaves the commission rates untouched
+	params.MinCommissionRate = sdk.ZeroDec()
+	keeper.SetParams(ctx, params)
+	keeper.EnforceMinCommissionRate(ctx)
+	require.Equal(t, sdk.ZeroDec(), keeper.GetLastMinCommissionRate(ctx))
+
+	val1, found = keeper.GetValidator(ctx, val1.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, minRate, val1.Commission.Rate)
+}
@@ -91,6 +91,11 @@ func ErrCommissionGTMaxChangeRate(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "commission cannot be changed more than max change rate")
 }
 
+func ErrCommissionLTMinRate(codespace sdk.CodespaceType, minRate sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator,
+		fmt.Sprintf("commission cannot be less than the min commission rate %s", minRate))
+}
+
 func ErrSelfDelegationBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "validator's self delegation must be greater than their minimum self delegation")
 }
@@ -166,6 +171,20 @@ func ErrMaxUnbondingDelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many unbonding delegation entries in this delegator/validator duo, please wait for some entries to mature")
 }
 
+func ErrNoUnbondingDelegationEntry(codespace sdk.CodespaceType, creationHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("no pending unbonding delegation entry found at creation height %d", creationHeight))
+}
+
+func ErrBadCancelUnbondingAmount(codespace sdk.CodespaceType, balance sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("amount to cancel must be > 0 and <= the unbonding entry balance %s", balance))
+}
+
+func ErrBadCreationHeight(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "creation height must be positive")
+}
+
 func ErrBadRedelegationAddr(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "unexpected address length for this (address, srcValidator, dstValidator) tuple")
 }
@@ -209,6 +228,29 @@ func ErrNeitherShareMsgsGiven(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidInput, "neither shares amount nor shares percent provided")
 }
 
+func ErrVotingPowerSoftLimitExceeded(codespace sdk.CodespaceType, limit sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("delegation would push the validator above %s of the bonded tokens", limit))
+}
+
+func ErrShareDenomTaken(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("share denomination %s is already used by another validator", denom))
+}
+
+func ErrNoShareDenomValidator(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation,
+		fmt.Sprintf("no validator with tokenized shares of denomination %s", denom))
+}
+
+func ErrTokenizeSharesVestingAccount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "vesting accounts cannot tokenize their delegations")
+}
+
+func ErrNoHistoricalInfo(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("no historical info found at height %d", height))
+}
+
 func ErrMissingSignature(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidValidator, "missing signature")
 }
@@ -2,13 +2,17 @@ package types
 
 // Staking module event types
 var (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeMinCommissionRate         = "min_commission_rate"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemTokensForShares     = "redeem_tokens_for_shares"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +21,7 @@ var (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyShareTokens       = "share_tokens"
 	AttributeValueCategory        = ModuleName
 )
@@ -28,10 +28,15 @@ type SupplyKeeper interface {
 	// TODO remove with genesis 2-phases refactor https://github.com/hyperspeednetwork/hsnhub/issues/2862
 	SetModuleAccount(sdk.Context, supplyexported.ModuleAccountI)
 
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderPool, recipientPool string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
 
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
 
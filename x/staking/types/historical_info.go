@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+)
+
+// HistoricalInfo contains the header and the bonded validator set at a given
+// height. It is stored by the staking module at every block so that the
+// validator set of a past height can be retrieved from state.
+type HistoricalInfo struct {
+	Header abci.Header `json:"header" yaml:"header"`
+	ValSet Validators  `json:"valset" yaml:"valset"`
+}
+
+// NewHistoricalInfo creates a new HistoricalInfo instance, sorting the
+// validator set by operator address
+func NewHistoricalInfo(header abci.Header, valSet Validators) HistoricalInfo {
+	sort.SliceStable(valSet, func(i, j int) bool {
+		return bytes.Compare(valSet[i].OperatorAddress, valSet[j].OperatorAddress) < 0
+	})
+	return HistoricalInfo{
+		Header: header,
+		ValSet: valSet,
+	}
+}
+
+// MustMarshalHistoricalInfo returns the amino-encoded historical info
+func MustMarshalHistoricalInfo(cdc *codec.Codec, hi HistoricalInfo) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(hi)
+}
+
+// MustUnmarshalHistoricalInfo unmarshals historical info from a store value
+// and panics on error
+func MustUnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) HistoricalInfo {
+	hi, err := UnmarshalHistoricalInfo(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return hi
+}
+
+// UnmarshalHistoricalInfo unmarshals historical info from a store value
+func UnmarshalHistoricalInfo(cdc *codec.Codec, value []byte) (hi HistoricalInfo, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &hi)
+	return hi, err
+}
+
+// ValidateBasic checks that the historical info has a positive height and a
+// non-empty validator set sorted by operator address
+func (hi HistoricalInfo) ValidateBasic() error {
+	if hi.Header.Height <= 0 {
+		return fmt.Errorf("historical info height must be positive, got %d", hi.Header.Height)
+	}
+	if len(hi.ValSet) == 0 {
+		return errors.New("validator set is empty")
+	}
+	for i := 1; i < len(hi.ValSet); i++ {
+		if bytes.Compare(hi.ValSet[i-1].OperatorAddress, hi.ValSet[i].OperatorAddress) >= 0 {
+			return errors.New("validator set is not sorted by operator address")
+		}
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a HistoricalInfo.
+func (hi HistoricalInfo) String() string {
+	return fmt.Sprintf(`Historical Info:
+  Height:    %d
+  Time:      %s
+  Chain ID:  %s
+  Validators:
+%s`, hi.Header.Height, hi.Header.Time, hi.Header.ChainID, hi.ValSet)
+}
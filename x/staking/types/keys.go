@@ -24,7 +24,7 @@ const (
 	RouterKey = ModuleName
 )
 
-//nolint
+// nolint
 var (
 	// Keys for store prefixes
 	// Last* values are constant during a block.
@@ -45,6 +45,12 @@ var (
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+
+	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	LastMinCommissionRateKey = []byte{0x60} // key for the last enforced minimum commission rate
+
+	ShareDenomKey = []byte{0x70} // prefix for each key to a validator, by the denomination of its tokenized shares
 )
 
 // gets the key for the validator with address
@@ -278,3 +284,17 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 		GetREDsToValDstIndexKey(valDstAddr),
 		delAddr.Bytes()...)
 }
+
+//______________
+
+// gets the key for the historical info at the given height
+// VALUE: staking/HistoricalInfo
+func GetHistoricalInfoKey(height int64) []byte {
+	return append(HistoricalInfoKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the key for the validator of a tokenized share denomination
+// VALUE: validator operator address ([]byte)
+func GetShareDenomKey(denom string) []byte {
+	return append(ShareDenomKey, []byte(denom)...)
+}
@@ -343,3 +343,141 @@ func (msg MsgUndelegate) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// MsgCancelUnbondingDelegation - struct for cancelling an unbonding delegation
+// entry and delegating its balance back to the original validator
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	CreationHeight   int64          `json:"creation_height" yaml:"creation_height"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgCancelUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	creationHeight int64, amount sdk.Coin) MsgCancelUnbondingDelegation {
+
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		CreationHeight:   creationHeight,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+func (msg MsgCancelUnbondingDelegation) Type() string  { return "cancel_unbonding_delegation" }
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.CreationHeight <= 0 {
+		return ErrBadCreationHeight(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgTokenizeShares - struct for converting part of a delegation into
+// transferable share tokens of the validator
+type MsgTokenizeShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+func (msg MsgTokenizeShares) Type() string  { return "tokenize_shares" }
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgTokenizeShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgRedeemTokensForShares - struct for redeeming share tokens of a validator
+// back into a delegation owned by the holder
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+//nolint
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+func (msg MsgRedeemTokensForShares) Type() string  { return "redeem_tokens_for_shares" }
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRedeemTokensForShares) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrBadSharesAmount(DefaultCodespace)
+	}
+	if !IsShareDenom(msg.Amount.Denom) {
+		return ErrNoShareDenomValidator(DefaultCodespace, msg.Amount.Denom)
+	}
+	return nil
+}
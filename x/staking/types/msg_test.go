@@ -154,3 +154,79 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCancelUnbondingDelegation
+func TestMsgCancelUnbondingDelegation(t *testing.T) {
+	tests := []struct {
+		name           string
+		delegatorAddr  sdk.AccAddress
+		validatorAddr  sdk.ValAddress
+		creationHeight int64
+		amount         sdk.Coin
+		expectPass     bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"zero creation height", sdk.AccAddress(valAddr1), valAddr2, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, 10, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgCancelUnbondingDelegation(tc.delegatorAddr, tc.validatorAddr, tc.creationHeight, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgTokenizeShares(t *testing.T) {
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), valAddr2, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTokenizeShares(tc.delegatorAddr, tc.validatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestMsgRedeemTokensForShares(t *testing.T) {
+	shareDenom := GetShareDenom(valAddr1)
+	tests := []struct {
+		name          string
+		delegatorAddr sdk.AccAddress
+		amount        sdk.Coin
+		expectPass    bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 1), true},
+		{"zero amount", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom, 0), false},
+		{"empty delegator", sdk.AccAddress(emptyAddr), sdk.NewInt64Coin(shareDenom, 1), false},
+		{"not a share denom", sdk.AccAddress(valAddr1), sdk.NewInt64Coin("stake", 1), false},
+		{"truncated share denom", sdk.AccAddress(valAddr1), sdk.NewInt64Coin(shareDenom[:15], 1), false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemTokensForShares(tc.delegatorAddr, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
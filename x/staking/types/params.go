@@ -22,36 +22,63 @@ const (
 
 	// Default maximum entries in a UBD/RED pair
 	DefaultMaxEntries uint16 = 7
+
+	// Default number of historical entries to persist, historical info is
+	// not stored by default
+	DefaultHistoricalEntries uint16 = 0
 )
 
+// Default voting power limits, a zero fraction disables the limit
+var (
+	DefaultMaxVotingPowerFraction = sdk.ZeroDec()
+	DefaultVotingPowerSoftLimit   = sdk.ZeroDec()
+)
+
+// DefaultMinCommissionRate is zero, which leaves validator commission rates
+// unrestricted
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
 // nolint - Keys for parameter access
 var (
-	KeyUnbondingTime = []byte("UnbondingTime")
-	KeyMaxValidators = []byte("MaxValidators")
-	KeyMaxEntries    = []byte("KeyMaxEntries")
-	KeyBondDenom     = []byte("BondDenom")
+	KeyUnbondingTime          = []byte("UnbondingTime")
+	KeyMaxValidators          = []byte("MaxValidators")
+	KeyMaxEntries             = []byte("KeyMaxEntries")
+	KeyHistoricalEntries      = []byte("HistoricalEntries")
+	KeyBondDenom              = []byte("BondDenom")
+	KeyMaxVotingPowerFraction = []byte("MaxVotingPowerFraction")
+	KeyVotingPowerSoftLimit   = []byte("VotingPowerSoftLimit")
+	KeyMinCommissionRate      = []byte("MinCommissionRate")
 )
 
 var _ params.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for staking
 type Params struct {
-	UnbondingTime time.Duration `json:"unbonding_time" yaml:"unbonding_time"` // time duration of unbonding
-	MaxValidators uint16        `json:"max_validators" yaml:"max_validators"` // maximum number of validators (max uint16 = 65535)
-	MaxEntries    uint16        `json:"max_entries" yaml:"max_entries"`       // max entries for either unbonding delegation or redelegation (per pair/trio)
+	UnbondingTime     time.Duration `json:"unbonding_time" yaml:"unbonding_time"`         // time duration of unbonding
+	MaxValidators     uint16        `json:"max_validators" yaml:"max_validators"`         // maximum number of validators (max uint16 = 65535)
+	MaxEntries        uint16        `json:"max_entries" yaml:"max_entries"`               // max entries for either unbonding delegation or redelegation (per pair/trio)
+	HistoricalEntries uint16        `json:"historical_entries" yaml:"historical_entries"` // number of most recent historical infos to persist
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom" yaml:"bond_denom"` // bondable coin denomination
+
+	MaxVotingPowerFraction sdk.Dec `json:"max_voting_power_fraction" yaml:"max_voting_power_fraction"` // maximum fraction of the total consensus power a single validator reports to Tendermint, zero disables the cap
+	VotingPowerSoftLimit   sdk.Dec `json:"voting_power_soft_limit" yaml:"voting_power_soft_limit"`     // maximum fraction of the bonded tokens a validator may reach through new delegations, zero disables the limit
+	MinCommissionRate      sdk.Dec `json:"min_commission_rate" yaml:"min_commission_rate"`             // chain-wide floor of validator commission rates
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint16,
+	bondDenom string, maxVotingPowerFraction, votingPowerSoftLimit, minCommissionRate sdk.Dec) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		HistoricalEntries:      historicalEntries,
+		BondDenom:              bondDenom,
+		MaxVotingPowerFraction: maxVotingPowerFraction,
+		VotingPowerSoftLimit:   votingPowerSoftLimit,
+		MinCommissionRate:      minCommissionRate,
 	}
 }
 
@@ -61,7 +88,11 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyUnbondingTime, &p.UnbondingTime},
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyMaxEntries, &p.MaxEntries},
+		{KeyHistoricalEntries, &p.HistoricalEntries},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyMaxVotingPowerFraction, &p.MaxVotingPowerFraction},
+		{KeyVotingPowerSoftLimit, &p.VotingPowerSoftLimit},
+		{KeyMinCommissionRate, &p.MinCommissionRate},
 	}
 }
 
@@ -75,17 +106,23 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, DefaultHistoricalEntries,
+		sdk.DefaultBondDenom, DefaultMaxVotingPowerFraction, DefaultVotingPowerSoftLimit, DefaultMinCommissionRate)
 }
 
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-  Unbonding Time:    %s
-  Max Validators:    %d
-  Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Unbonding Time:            %s
+  Max Validators:            %d
+  Max Entries:               %d
+  Historical Entries:        %d
+  Bonded Coin Denom:         %s
+  Max Voting Power Fraction: %s
+  Voting Power Soft Limit:   %s
+  Min Commission Rate:       %s`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.HistoricalEntries, p.BondDenom,
+		p.MaxVotingPowerFraction, p.VotingPowerSoftLimit, p.MinCommissionRate)
 }
 
 // unmarshal the current staking params value from store key or panic
@@ -114,5 +151,14 @@ func (p Params) Validate() error {
 	if p.MaxValidators == 0 {
 		return fmt.Errorf("staking parameter MaxValidators must be a positive integer")
 	}
+	if p.MaxVotingPowerFraction.IsNegative() || p.MaxVotingPowerFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MaxVotingPowerFraction must be between 0 and 1, is %s", p.MaxVotingPowerFraction)
+	}
+	if p.VotingPowerSoftLimit.IsNegative() || p.VotingPowerSoftLimit.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter VotingPowerSoftLimit must be between 0 and 1, is %s", p.VotingPowerSoftLimit)
+	}
+	if p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking parameter MinCommissionRate must be between 0 and 1, is %s", p.MinCommissionRate)
+	}
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,31 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateVotingPowerLimits(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.MaxVotingPowerFraction = sdk.NewDecWithPrec(33, 2)
+	p.VotingPowerSoftLimit = sdk.OneDec()
+	require.NoError(t, p.Validate())
+
+	p.MaxVotingPowerFraction = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, p.Validate())
+
+	p.MaxVotingPowerFraction = sdk.ZeroDec()
+	p.VotingPowerSoftLimit = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, p.Validate())
+}
+
+func TestParamsValidateMinCommissionRate(t *testing.T) {
+	p := DefaultParams()
+	p.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDecWithPrec(-5, 2)
+	require.Error(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+}
@@ -20,6 +20,7 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryHistoricalInfo                = "historicalInfo"
 )
 
 // defines the params for the following queries:
@@ -96,3 +97,14 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// QueryHistoricalInfoParams defines the params for the following queries:
+// - 'custom/staking/historicalInfo'
+type QueryHistoricalInfoParams struct {
+	Height int64
+}
+
+// NewQueryHistoricalInfoParams creates a new QueryHistoricalInfoParams instance
+func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
+	return QueryHistoricalInfoParams{height}
+}
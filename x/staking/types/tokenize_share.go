@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/hex"
+	"regexp"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// ShareDenomPrefix prefixes the denomination of tokenized shares. Coin
+// denominations are limited to 16 characters, so the prefix is followed by the
+// hex encoding of the first shareDenomHashBytes bytes of the hash of the
+// operator address.
+const (
+	ShareDenomPrefix    = "sh"
+	shareDenomHashBytes = 7
+)
+
+var reShareDenom = regexp.MustCompile(`^sh[0-9a-f]{14}$`)
+
+// GetShareDenom returns the bank denomination of the tokenized shares of a
+// validator. One share token represents one delegator share of the validator.
+func GetShareDenom(valAddr sdk.ValAddress) string {
+	return ShareDenomPrefix + hex.EncodeToString(tmhash.Sum(valAddr.Bytes())[:shareDenomHashBytes])
+}
+
+// IsShareDenom returns true if the denomination has the format of tokenized
+// shares. Such denominations are reserved to x/staking.
+func IsShareDenom(denom string) bool {
+	return reShareDenom.MatchString(denom)
+}
+
+// GetTokenizeShareHolderAddress returns the address owning the delegation
+// backing the tokenized shares of a validator. No private key exists for the
+// address, the rewards it is paid are restaked for the share token holders.
+func GetTokenizeShareHolderAddress(valAddr sdk.ValAddress) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash(append([]byte(TokenizeSharePoolName), valAddr.Bytes()...)))
+}
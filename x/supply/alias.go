@@ -11,40 +11,65 @@ import (
 )
 
 const (
-	ModuleName   = types.ModuleName
-	StoreKey     = types.StoreKey
-	RouterKey    = types.RouterKey
-	QuerierRoute = types.QuerierRoute
-	Minter       = types.Minter
-	Burner       = types.Burner
-	Staking      = types.Staking
+	ModuleName                   = types.ModuleName
+	StoreKey                     = types.StoreKey
+	RouterKey                    = types.RouterKey
+	QuerierRoute                 = types.QuerierRoute
+	Minter                       = types.Minter
+	Burner                       = types.Burner
+	Staking                      = types.Staking
+	CodeInvalidDenomMetadata     = types.CodeInvalidDenomMetadata
+	ProposalTypeSetDenomMetadata = types.ProposalTypeSetDenomMetadata
+	QueryTotalSupply             = types.QueryTotalSupply
+	QuerySupplyOf                = types.QuerySupplyOf
+	QueryDenomMetadata           = types.QueryDenomMetadata
+	QueryAllDenomMetadata        = types.QueryAllDenomMetadata
+	MaxDenomUnitExponent         = types.MaxDenomUnitExponent
 )
 
 var (
 	// functions aliases
-	RegisterInvariants    = keeper.RegisterInvariants
-	AllInvariants         = keeper.AllInvariants
-	TotalSupply           = keeper.TotalSupply
-	NewKeeper             = keeper.NewKeeper
-	NewQuerier            = keeper.NewQuerier
-	SupplyKey             = keeper.SupplyKey
-	NewModuleAddress      = types.NewModuleAddress
-	NewEmptyModuleAccount = types.NewEmptyModuleAccount
-	NewModuleAccount      = types.NewModuleAccount
-	RegisterCodec         = types.RegisterCodec
-	NewGenesisState       = types.NewGenesisState
-	DefaultGenesisState   = types.DefaultGenesisState
-	NewSupply             = types.NewSupply
-	DefaultSupply         = types.DefaultSupply
+	RegisterInvariants          = keeper.RegisterInvariants
+	AllInvariants               = keeper.AllInvariants
+	TotalSupply                 = keeper.TotalSupply
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	SupplyKey                   = keeper.SupplyKey
+	NewModuleAddress            = types.NewModuleAddress
+	NewEmptyModuleAccount       = types.NewEmptyModuleAccount
+	NewModuleAccount            = types.NewModuleAccount
+	RegisterCodec               = types.RegisterCodec
+	NewGenesisState             = types.NewGenesisState
+	DefaultGenesisState         = types.DefaultGenesisState
+	NewSupply                   = types.NewSupply
+	DefaultSupply               = types.DefaultSupply
+	GetDenomMetadataKey         = keeper.GetDenomMetadataKey
+	ErrInvalidDenomMetadata     = types.ErrInvalidDenomMetadata
+	NewDenomUnit                = types.NewDenomUnit
+	NewMetadata                 = types.NewMetadata
+	ConvertToBaseCoins          = types.ConvertToBaseCoins
+	ConvertToDisplayCoins       = types.ConvertToDisplayCoins
+	NewSetDenomMetadataProposal = types.NewSetDenomMetadataProposal
+	NewQueryTotalSupplyParams   = types.NewQueryTotalSupplyParams
+	NewQuerySupplyOfParams      = types.NewQuerySupplyOfParams
+	NewQueryDenomMetadataParams = types.NewQueryDenomMetadataParams
 
 	// variable aliases
-	DefaultCodespace = keeper.DefaultCodespace
-	ModuleCdc        = types.ModuleCdc
+	DefaultCodespace       = keeper.DefaultCodespace
+	ModuleCdc              = types.ModuleCdc
+	DenomMetadataKeyPrefix = keeper.DenomMetadataKeyPrefix
 )
 
 type (
-	Keeper        = keeper.Keeper
-	ModuleAccount = types.ModuleAccount
-	GenesisState  = types.GenesisState
-	Supply        = types.Supply
+	Keeper                   = keeper.Keeper
+	ModuleAccount            = types.ModuleAccount
+	GenesisState             = types.GenesisState
+	Supply                   = types.Supply
+	DenomUnit                = types.DenomUnit
+	Metadata                 = types.Metadata
+	MetadataList             = types.MetadataList
+	SetDenomMetadataProposal = types.SetDenomMetadataProposal
+	QueryTotalSupplyParams   = types.QueryTotalSupplyParams
+	QuerySupplyOfParams      = types.QuerySupplyOfParams
+	QueryDenomMetadataParams = types.QueryDenomMetadataParams
 )
@@ -5,12 +5,15 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/hyperspeednetwork/hsnhub/client"
 	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/client/flags"
 	"github.com/hyperspeednetwork/hsnhub/codec"
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/client/utils"
 	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
 )
 
@@ -27,6 +30,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryDenomMetadata(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -34,7 +38,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryTotalSupply implements the query total supply command.
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "total [denom]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Query the total supply of coins of the chain",
@@ -47,8 +51,12 @@ $ %s query %s total
 
 To query for the total supply of a specific coin denomination use:
 $ %s query %s total stake
+
+To print the amounts in the display units of their denominations use:
+$ %s query %s total --display-units
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -60,6 +68,59 @@ $ %s query %s total stake
 			return querySupplyOf(cliCtx, cdc, args[0])
 		},
 	}
+
+	cmd.Flags().Bool(flags.FlagDisplayUnits, false, "Print amounts in the display units of their denominations")
+	return cmd
+}
+
+// GetCmdQueryDenomMetadata implements the query denom metadata command.
+func GetCmdQueryDenomMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the metadata of the registered coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the metadata of all the registered coin denominations.
+
+Example:
+$ %s query %s denom-metadata
+
+To query for the metadata of a specific coin denomination use:
+$ %s query %s denom-metadata uhsn
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 0 {
+				metadata, err := utils.QueryAllDenomMetadata(cliCtx)
+				if err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(metadata)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDenomMetadataParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := cdc.UnmarshalJSON(res, &metadata); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
 }
 
 func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
@@ -80,6 +141,14 @@ func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
 		return err
 	}
 
+	if viper.GetBool(flags.FlagDisplayUnits) {
+		displaySupply, err := utils.ConvertToDisplayCoins(cliCtx, totalSupply)
+		if err != nil {
+			return err
+		}
+		return cliCtx.PrintOutput(displaySupply)
+	}
+
 	return cliCtx.PrintOutput(totalSupply)
 }
 
@@ -101,5 +170,13 @@ func querySupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) er
 		return err
 	}
 
+	if viper.GetBool(flags.FlagDisplayUnits) {
+		displaySupply, err := utils.ConvertToDisplayCoins(cliCtx, sdk.NewCoins(sdk.NewCoin(denom, supply)))
+		if err != nil {
+			return err
+		}
+		return cliCtx.PrintOutput(displaySupply)
+	}
+
 	return cliCtx.PrintOutput(supply)
 }
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
+)
+
+// GetCmdSubmitSetDenomMetadataProposal implements the command to submit a
+// set-denom-metadata proposal
+func GetCmdSubmitSetDenomMetadataProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the metadata of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register or update the metadata of a coin denomination
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Register HSN",
+  "description": "Register the display units of the uhsn denomination",
+  "metadata": {
+    "description": "The native staking token.",
+    "base": "uhsn",
+    "display": "hsn",
+    "denom_units": [
+      {
+        "denom": "uhsn",
+        "exponent": 0,
+        "aliases": ["microhsn"]
+      },
+      {
+        "denom": "hsn",
+        "exponent": 6
+      }
+    ]
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseSetDenomMetadataProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
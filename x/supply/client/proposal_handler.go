@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/hyperspeednetwork/hsnhub/x/gov/client"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/client/cli"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/client/rest"
+)
+
+// set denom metadata proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetDenomMetadataProposal, rest.ProposalRESTHandler)
+)
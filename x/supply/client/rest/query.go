@@ -11,11 +11,6 @@ import (
 	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
 )
 
-// RegisterRoutes registers staking-related REST handlers to a router
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
-	registerQueryRoutes(cliCtx, r)
-}
-
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	// Query the total supply of coins
 	r.HandleFunc(
@@ -28,6 +23,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/supply/total/{denom}",
 		supplyOfHandlerFn(cliCtx),
 	).Methods("GET")
+
+	// Query the metadata of all the registered denoms
+	r.HandleFunc(
+		"/supply/denom_metadata",
+		allDenomMetadataHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Query the metadata of a single denom
+	r.HandleFunc(
+		"/supply/denom_metadata/{denom}",
+		denomMetadataHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // HTTP request handler to query the total supply of coins
@@ -88,3 +95,49 @@ func supplyOfHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the metadata of all the registered denoms
+func allDenomMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDenomMetadata), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the metadata of a single denom
+func denomMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQueryDenomMetadataParams(denom)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
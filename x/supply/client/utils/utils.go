@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
+)
+
+// QueryAllDenomMetadata queries the metadata of all the registered
+// denominations.
+func QueryAllDenomMetadata(cliCtx context.CLIContext) (types.MetadataList, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllDenomMetadata), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata types.MetadataList
+	if err := cliCtx.Codec.UnmarshalJSON(res, &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// ParseDisplayCoins parses a comma separated list of coins which may be
+// expressed in any unit of their denomination, eg. "1.5hsn,100stake", and
+// converts them to their base units using the registered denom metadata.
+func ParseDisplayCoins(cliCtx context.CLIContext, coinsStr string) (sdk.Coins, error) {
+	coins, err := parseDecCoins(coinsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := QueryAllDenomMetadata(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ConvertToBaseCoins(metadata, coins)
+}
+
+// ConvertToDisplayCoins converts coins in base units to the display units of
+// their denominations using the registered denom metadata.
+func ConvertToDisplayCoins(cliCtx context.CLIContext, coins sdk.Coins) (sdk.DecCoins, error) {
+	metadata, err := QueryAllDenomMetadata(cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ConvertToDisplayCoins(metadata, coins), nil
+}
+
+// parseDecCoins parses a list of coins whose amounts may or may not have a
+// fractional part.
+func parseDecCoins(coinsStr string) (sdk.DecCoins, error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	var coins sdk.DecCoins
+	for _, coinStr := range strings.Split(coinsStr, ",") {
+		if strings.Contains(coinStr, ".") {
+			coin, err := sdk.ParseDecCoin(coinStr)
+			if err != nil {
+				return nil, err
+			}
+			coins = append(coins, coin)
+			continue
+		}
+
+		coin, err := sdk.ParseCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, sdk.NewDecCoinFromCoin(coin))
+	}
+
+	coins = coins.Sort()
+	if !coins.IsValid() {
+		return nil, fmt.Errorf("parsed decimal coins are invalid: %s", coins)
+	}
+
+	return coins, nil
+}
@@ -24,15 +24,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, ak types.AccountKeeper, data Ge
 	}
 
 	keeper.SetSupply(ctx, types.NewSupply(data.Supply))
+
+	for _, metadata := range data.DenomMetadata {
+		keeper.SetDenomMetadata(ctx, metadata)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSupply(ctx).GetTotal())
+	return NewGenesisState(keeper.GetSupply(ctx).GetTotal(), keeper.GetAllDenomMetadata(ctx))
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	return types.NewSupply(data.Supply).ValidateBasic()
+	if err := types.NewSupply(data.Supply).ValidateBasic(); err != nil {
+		return err
+	}
+
+	return data.ValidateDenomMetadata()
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	authexported "github.com/hyperspeednetwork/hsnhub/x/auth/exported"
 	"github.com/hyperspeednetwork/hsnhub/x/supply/exported"
 	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
 )
@@ -24,6 +25,11 @@ func (k Keeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.AccA
 	return permAddr.GetAddress(), permAddr.GetPermissions()
 }
 
+// GetAccount returns the account stored at the given address, if any
+func (k Keeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account {
+	return k.ak.GetAccount(ctx, addr)
+}
+
 // GetModuleAccountAndPermissions gets the module account from the auth account store and its
 // registered permissions
 func (k Keeper) GetModuleAccountAndPermissions(ctx sdk.Context, moduleName string) (exported.ModuleAccountI, []string) {
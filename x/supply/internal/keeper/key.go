@@ -12,6 +12,14 @@ var DefaultCodespace sdk.CodespaceType = types.ModuleName
 // Items are stored with the following key: values
 //
 // - 0x00: Supply
+//
+// - 0x01<base_denom_Bytes>: Metadata
 var (
-	SupplyKey = []byte{0x00}
+	SupplyKey              = []byte{0x00}
+	DenomMetadataKeyPrefix = []byte{0x01}
 )
+
+// GetDenomMetadataKey returns the key of the metadata of a base denomination
+func GetDenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
+)
+
+// GetDenomMetadata returns the metadata of the given base denomination, if
+// any.
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (metadata types.Metadata, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetDenomMetadataKey(denom))
+	if bz == nil {
+		return metadata, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetadata stores the metadata of a denomination, replacing any
+// existing metadata for its base denom.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(metadata)
+	store.Set(GetDenomMetadataKey(metadata.Base), bz)
+}
+
+// IterateDenomMetadata iterates over the metadata of all denominations. For
+// each metadata, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k Keeper) IterateDenomMetadata(ctx sdk.Context, cb func(types.Metadata) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DenomMetadataKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetadata returns the metadata of all denominations.
+func (k Keeper) GetAllDenomMetadata(ctx sdk.Context) []types.Metadata {
+	metadata := []types.Metadata{}
+	k.IterateDenomMetadata(ctx, func(m types.Metadata) bool {
+		metadata = append(metadata, m)
+		return false
+	})
+
+	return metadata
+}
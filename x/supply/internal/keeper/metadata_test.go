@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/x/supply/internal/types"
+)
+
+func TestDenomMetadata(t *testing.T) {
+	ctx, _, keeper := createTestInput(t, false, 1000, 2)
+
+	atom := types.NewMetadata("The native staking token", "uatom", "atom", []types.DenomUnit{
+		types.NewDenomUnit("uatom", 0),
+		types.NewDenomUnit("atom", 6),
+	})
+	photon := types.NewMetadata("", "photon", "photon", []types.DenomUnit{
+		types.NewDenomUnit("photon", 0),
+	})
+
+	_, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllDenomMetadata(ctx))
+
+	keeper.SetDenomMetadata(ctx, atom)
+	keeper.SetDenomMetadata(ctx, photon)
+
+	metadata, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, metadata)
+
+	// metadata is keyed by the base denom only
+	_, found = keeper.GetDenomMetadata(ctx, "atom")
+	require.False(t, found)
+
+	// setting the metadata of an existing base denom replaces it
+	atom.Description = "updated"
+	keeper.SetDenomMetadata(ctx, atom)
+	require.Equal(t, []types.Metadata{photon, atom}, keeper.GetAllDenomMetadata(ctx))
+}
+
+func TestQueryDenomMetadata(t *testing.T) {
+	ctx, _, keeper := createTestInput(t, false, 1000, 2)
+	querier := NewQuerier(keeper)
+
+	atom := types.NewMetadata("", "uatom", "atom", []types.DenomUnit{
+		types.NewDenomUnit("uatom", 0),
+		types.NewDenomUnit("atom", 6),
+	})
+	keeper.SetDenomMetadata(ctx, atom)
+
+	bz, err := keeper.cdc.MarshalJSON(types.NewQueryDenomMetadataParams("uatom"))
+	require.NoError(t, err)
+
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/supply/%s", types.QueryDenomMetadata),
+		Data: bz,
+	}
+
+	res, sdkErr := querier(ctx, []string{types.QueryDenomMetadata}, query)
+	require.Nil(t, sdkErr)
+
+	var metadata types.Metadata
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &metadata))
+	require.Equal(t, atom, metadata)
+
+	bz, err = keeper.cdc.MarshalJSON(types.NewQueryDenomMetadataParams("photon"))
+	require.NoError(t, err)
+	query.Data = bz
+
+	_, sdkErr = querier(ctx, []string{types.QueryDenomMetadata}, query)
+	require.NotNil(t, sdkErr)
+
+	query.Path = fmt.Sprintf("/custom/supply/%s", types.QueryAllDenomMetadata)
+	query.Data = nil
+
+	res, sdkErr = querier(ctx, []string{types.QueryAllDenomMetadata}, query)
+	require.Nil(t, sdkErr)
+
+	var allMetadata []types.Metadata
+	require.NoError(t, keeper.cdc.UnmarshalJSON(res, &allMetadata))
+	require.Equal(t, []types.Metadata{atom}, allMetadata)
+}
@@ -11,6 +11,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.SupplyI)(nil), nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(&Supply{}, "cosmos-sdk/Supply", nil)
+	cdc.RegisterConcrete(SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
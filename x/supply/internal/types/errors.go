@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidDenomMetadata sdk.CodeType = 1
+)
+
+// ErrInvalidDenomMetadata returns a typed error when denomination metadata is
+// invalid
+func ErrInvalidDenomMetadata(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDenomMetadata, "invalid denom metadata: "+err.Error())
+}
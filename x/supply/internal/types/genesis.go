@@ -1,20 +1,41 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/hyperspeednetwork/hsnhub/types"
 )
 
 // GenesisState is the supply state that must be provided at genesis.
 type GenesisState struct {
-	Supply sdk.Coins `json:"supply" yaml:"supply"`
+	Supply        sdk.Coins  `json:"supply" yaml:"supply"`
+	DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(supply sdk.Coins) GenesisState {
-	return GenesisState{supply}
+func NewGenesisState(supply sdk.Coins, denomMetadata []Metadata) GenesisState {
+	return GenesisState{supply, denomMetadata}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultSupply().GetTotal(), []Metadata{})
+}
+
+// ValidateDenomMetadata validates the denomination metadata of the genesis
+// state, which must have a distinct base denom for each denomination.
+func (gs GenesisState) ValidateDenomMetadata() error {
+	seenBases := make(map[string]bool)
+	for _, metadata := range gs.DenomMetadata {
+		if seenBases[metadata.Base] {
+			return fmt.Errorf("duplicate denom metadata for %s", metadata.Base)
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenBases[metadata.Base] = true
+	}
+
+	return nil
 }
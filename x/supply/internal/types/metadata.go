@@ -0,0 +1,239 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// MaxDenomUnitExponent is the largest exponent of a denomination unit, bounded
+// by the precision of sdk.Dec so that amounts can be converted between units
+// without loss.
+const MaxDenomUnitExponent = sdk.Precision
+
+// DenomUnit defines a unit of a denomination which is worth 10^Exponent of
+// its base unit. A unit may be referred to by any of its aliases.
+type DenomUnit struct {
+	Denom    string   `json:"denom" yaml:"denom"`
+	Exponent uint32   `json:"exponent" yaml:"exponent"`
+	Aliases  []string `json:"aliases" yaml:"aliases"`
+}
+
+// NewDenomUnit creates a new DenomUnit object
+func NewDenomUnit(denom string, exponent uint32, aliases ...string) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+		Aliases:  aliases,
+	}
+}
+
+// Metadata defines the metadata of a denomination: its base unit, which is
+// the denomination coins are held and transferred in, the unit it is usually
+// displayed in, and all of its units. For example:
+//
+//	{
+//	  "description": "The native staking token",
+//	  "base": "uhsn",
+//	  "display": "hsn",
+//	  "denom_units": [
+//	    {"denom": "uhsn", "exponent": 0, "aliases": ["microhsn"]},
+//	    {"denom": "mhsn", "exponent": 3, "aliases": ["millihsn"]},
+//	    {"denom": "hsn", "exponent": 6}
+//	  ]
+//	}
+type Metadata struct {
+	Description string      `json:"description" yaml:"description"`
+	Base        string      `json:"base" yaml:"base"`
+	Display     string      `json:"display" yaml:"display"`
+	DenomUnits  []DenomUnit `json:"denom_units" yaml:"denom_units"`
+}
+
+// NewMetadata creates a new Metadata object
+func NewMetadata(description, base, display string, denomUnits []DenomUnit) Metadata {
+	return Metadata{
+		Description: description,
+		Base:        base,
+		Display:     display,
+		DenomUnits:  denomUnits,
+	}
+}
+
+// Validate performs basic validation of the denomination metadata. The units
+// must start with the base unit, with an exponent of zero, be ordered by
+// increasing exponent and include the display unit.
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid base denom: %s", err)
+	}
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid display denom: %s", err)
+	}
+
+	if len(m.DenomUnits) == 0 || m.DenomUnits[0].Denom != m.Base || m.DenomUnits[0].Exponent != 0 {
+		return fmt.Errorf("the first denomination unit must be the base denom %s with exponent 0", m.Base)
+	}
+
+	seenDenoms := make(map[string]bool)
+	hasDisplay := false
+	for i, unit := range m.DenomUnits {
+		if i > 0 && unit.Exponent <= m.DenomUnits[i-1].Exponent {
+			return fmt.Errorf("denomination units must be sorted by increasing exponent")
+		}
+		if unit.Exponent > MaxDenomUnitExponent {
+			return fmt.Errorf("exponent of denomination unit %s is greater than %d", unit.Denom, MaxDenomUnitExponent)
+		}
+
+		for _, denom := range append([]string{unit.Denom}, unit.Aliases...) {
+			if err := sdk.ValidateDenom(denom); err != nil {
+				return fmt.Errorf("invalid denomination unit: %s", err)
+			}
+			if seenDenoms[denom] {
+				return fmt.Errorf("duplicate denomination unit %s", denom)
+			}
+			seenDenoms[denom] = true
+		}
+
+		if unit.Denom == m.Display {
+			hasDisplay = true
+		}
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("the display denom %s is not a denomination unit", m.Display)
+	}
+
+	return nil
+}
+
+// Unit returns the unit which has the given denomination or alias, if any.
+func (m Metadata) Unit(denom string) (DenomUnit, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit.Denom == denom {
+			return unit, true
+		}
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit, true
+			}
+		}
+	}
+
+	return DenomUnit{}, false
+}
+
+// ToBaseCoin converts an amount of any unit of the denomination to the base
+// unit. An error is returned if the unit is unknown or the amount is not a
+// whole number of base units.
+func (m Metadata) ToBaseCoin(coin sdk.DecCoin) (sdk.Coin, error) {
+	unit, ok := m.Unit(coin.Denom)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("%s is not a unit of %s", coin.Denom, m.Base)
+	}
+
+	amount := coin.Amount.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(unit.Exponent))))
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s is not a whole number of %s", coin, m.Base)
+	}
+
+	return sdk.NewCoin(m.Base, amount.TruncateInt()), nil
+}
+
+// ToDisplayCoin converts an amount of the base unit of the denomination to
+// the display unit.
+func (m Metadata) ToDisplayCoin(coin sdk.Coin) (sdk.DecCoin, error) {
+	if coin.Denom != m.Base {
+		return sdk.DecCoin{}, fmt.Errorf("%s is not the base denom %s", coin.Denom, m.Base)
+	}
+
+	unit, ok := m.Unit(m.Display)
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("the display denom %s is not a denomination unit", m.Display)
+	}
+
+	return sdk.NewDecCoinFromDec(m.Display, sdk.NewDecFromIntWithPrec(coin.Amount, int64(unit.Exponent))), nil
+}
+
+// String implements the Stringer interface.
+func (m Metadata) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Denom Metadata:
+  Description: %s
+  Base:        %s
+  Display:     %s
+  Units:`, m.Description, m.Base, m.Display))
+
+	for _, unit := range m.DenomUnits {
+		b.WriteString(fmt.Sprintf("\n    %s (exponent %d)", unit.Denom, unit.Exponent))
+		if len(unit.Aliases) > 0 {
+			b.WriteString(fmt.Sprintf(" aliases: %s", strings.Join(unit.Aliases, ", ")))
+		}
+	}
+
+	return b.String()
+}
+
+// MetadataList defines the metadata of a list of denominations.
+type MetadataList []Metadata
+
+// String implements the Stringer interface.
+func (ml MetadataList) String() (out string) {
+	for _, m := range ml {
+		out += m.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// ConvertToBaseCoins converts coins in any unit of the given denominations to
+// their base units. Coins of denominations without metadata are kept as they
+// are and must be whole numbers.
+func ConvertToBaseCoins(metadata []Metadata, coins sdk.DecCoins) (sdk.Coins, error) {
+	baseCoins := sdk.Coins{}
+	for _, coin := range coins {
+		baseCoin, err := toBaseCoin(metadata, coin)
+		if err != nil {
+			return nil, err
+		}
+
+		baseCoins = baseCoins.Add(sdk.Coins{baseCoin})
+	}
+
+	return baseCoins, nil
+}
+
+func toBaseCoin(metadata []Metadata, coin sdk.DecCoin) (sdk.Coin, error) {
+	for _, m := range metadata {
+		if _, ok := m.Unit(coin.Denom); ok {
+			return m.ToBaseCoin(coin)
+		}
+	}
+
+	if !coin.Amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s is not a whole number of %s", coin, coin.Denom)
+	}
+
+	return sdk.NewCoin(coin.Denom, coin.Amount.TruncateInt()), nil
+}
+
+// ConvertToDisplayCoins converts coins in base units to the display units of
+// their denominations. Coins of denominations without metadata are kept as
+// they are.
+func ConvertToDisplayCoins(metadata []Metadata, coins sdk.Coins) sdk.DecCoins {
+	displayCoins := sdk.DecCoins{}
+	for _, coin := range coins {
+		displayCoin := sdk.NewDecCoinFromCoin(coin)
+		for _, m := range metadata {
+			if m.Base == coin.Denom {
+				if c, err := m.ToDisplayCoin(coin); err == nil {
+					displayCoin = c
+				}
+				break
+			}
+		}
+
+		displayCoins = displayCoins.Add(sdk.DecCoins{displayCoin})
+	}
+
+	return displayCoins
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func atomMetadata() Metadata {
+	return NewMetadata("The native staking token", "uatom", "atom", []DenomUnit{
+		NewDenomUnit("uatom", 0, "microatom"),
+		NewDenomUnit("matom", 3, "milliatom"),
+		NewDenomUnit("atom", 6),
+	})
+}
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		metadata  Metadata
+		expectErr bool
+	}{
+		{"valid metadata", atomMetadata(), false},
+		{"base only", NewMetadata("", "stake", "stake", []DenomUnit{NewDenomUnit("stake", 0)}), false},
+		{"invalid base", NewMetadata("", "U", "atom", atomMetadata().DenomUnits), true},
+		{"invalid display", NewMetadata("", "uatom", "A", atomMetadata().DenomUnits), true},
+		{"no units", NewMetadata("", "uatom", "uatom", nil), true},
+		{"first unit is not base", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("atom", 0),
+			NewDenomUnit("uatom", 6),
+		}), true},
+		{"base exponent is not zero", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 1),
+			NewDenomUnit("atom", 6),
+		}), true},
+		{"unsorted exponents", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 0),
+			NewDenomUnit("atom", 6),
+			NewDenomUnit("matom", 3),
+		}), true},
+		{"duplicate exponents", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 0),
+			NewDenomUnit("matom", 6),
+			NewDenomUnit("atom", 6),
+		}), true},
+		{"exponent too large", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 0),
+			NewDenomUnit("atom", MaxDenomUnitExponent+1),
+		}), true},
+		{"duplicate alias", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 0, "atom"),
+			NewDenomUnit("atom", 6),
+		}), true},
+		{"invalid alias", NewMetadata("", "uatom", "atom", []DenomUnit{
+			NewDenomUnit("uatom", 0, "µatom"),
+			NewDenomUnit("atom", 6),
+		}), true},
+		{"display is not a unit", NewMetadata("", "uatom", "katom", atomMetadata().DenomUnits), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.metadata.Validate()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestMetadataUnit(t *testing.T) {
+	metadata := atomMetadata()
+
+	unit, ok := metadata.Unit("atom")
+	require.True(t, ok)
+	require.Equal(t, uint32(6), unit.Exponent)
+
+	unit, ok = metadata.Unit("milliatom")
+	require.True(t, ok)
+	require.Equal(t, "matom", unit.Denom)
+
+	_, ok = metadata.Unit("stake")
+	require.False(t, ok)
+}
+
+func TestMetadataConversions(t *testing.T) {
+	metadata := atomMetadata()
+
+	coin, err := metadata.ToBaseCoin(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1500000), coin)
+
+	coin, err = metadata.ToBaseCoin(sdk.NewInt64DecCoin("milliatom", 2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 2000), coin)
+
+	_, err = metadata.ToBaseCoin(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 7)))
+	require.Error(t, err)
+
+	_, err = metadata.ToBaseCoin(sdk.NewInt64DecCoin("stake", 1))
+	require.Error(t, err)
+
+	displayCoin, err := metadata.ToDisplayCoin(sdk.NewInt64Coin("uatom", 1500000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(15, 1)), displayCoin)
+
+	_, err = metadata.ToDisplayCoin(sdk.NewInt64Coin("atom", 1))
+	require.Error(t, err)
+}
+
+func TestConvertCoins(t *testing.T) {
+	metadata := []Metadata{atomMetadata()}
+
+	decCoins := sdk.NewDecCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Add(
+		sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 1))},
+	)
+	coins, err := ConvertToBaseCoins(metadata, decCoins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uatom", 2500000)), coins)
+
+	_, err = ConvertToBaseCoins(metadata, sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1))})
+	require.Error(t, err)
+
+	displayCoins := ConvertToDisplayCoins(metadata, coins)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 1)),
+		sdk.NewInt64DecCoin("stake", 10),
+	}, displayCoins)
+}
+
+func TestSetDenomMetadataProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewSetDenomMetadataProposal("title", "description", atomMetadata()).ValidateBasic())
+	require.Error(t, NewSetDenomMetadataProposal("", "description", atomMetadata()).ValidateBasic())
+
+	invalid := atomMetadata()
+	invalid.Display = "katom"
+	require.Error(t, NewSetDenomMetadataProposal("title", "description", invalid).ValidateBasic())
+}
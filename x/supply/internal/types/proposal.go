@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+)
+
+// Assert SetDenomMetadataProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = SetDenomMetadataProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+}
+
+// SetDenomMetadataProposal sets the metadata of a denomination, replacing any
+// existing metadata for its base denom
+type SetDenomMetadataProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Metadata    Metadata `json:"metadata" yaml:"metadata"`
+}
+
+// NewSetDenomMetadataProposal creates a new set denom metadata proposal.
+func NewSetDenomMetadataProposal(title, description string, metadata Metadata) SetDenomMetadataProposal {
+	return SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) GetTitle() string { return sdp.Title }
+
+// GetDescription returns the description of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) GetDescription() string { return sdp.Description }
+
+// ProposalRoute returns the routing key of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set denom metadata proposal.
+func (sdp SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (sdp SetDenomMetadataProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, sdp); err != nil {
+		return err
+	}
+	if err := sdp.Metadata.Validate(); err != nil {
+		return ErrInvalidDenomMetadata(DefaultCodespace, err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sdp SetDenomMetadataProposal) String() string {
+	return fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+%s
+`, sdp.Title, sdp.Description, sdp.Metadata)
+}
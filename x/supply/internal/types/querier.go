@@ -2,8 +2,10 @@ package types
 
 // query endpoints supported by the supply Querier
 const (
-	QueryTotalSupply = "total_supply"
-	QuerySupplyOf    = "supply_of"
+	QueryTotalSupply      = "total_supply"
+	QuerySupplyOf         = "supply_of"
+	QueryDenomMetadata    = "denom_metadata"
+	QueryAllDenomMetadata = "all_denom_metadata"
 )
 
 // QueryTotalSupply defines the params for the following queries:
@@ -30,3 +32,16 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// QueryDenomMetadataParams defines the params for the following queries:
+//
+// - 'custom/supply/denom_metadata'
+type QueryDenomMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomMetadataParams creates a new instance to query the metadata of
+// a given base denomination
+func NewQueryDenomMetadataParams(denom string) QueryDenomMetadataParams {
+	return QueryDenomMetadataParams{denom}
+}
@@ -0,0 +1,34 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+// NewDenomMetadataProposalHandler creates a new governance Handler for a
+// SetDenomMetadataProposal
+func NewDenomMetadataProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SetDenomMetadataProposal:
+			return handleSetDenomMetadataProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized supply proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, p SetDenomMetadataProposal) sdk.Error {
+	if err := p.Metadata.Validate(); err != nil {
+		return ErrInvalidDenomMetadata(DefaultCodespace, err)
+	}
+
+	k.SetDenomMetadata(ctx, p.Metadata)
+	k.Logger(ctx).Info(fmt.Sprintf("set metadata of denom %s", p.Metadata.Base))
+
+	return nil
+}
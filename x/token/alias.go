@@ -0,0 +1,86 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/token/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/token/internal/types
+package token
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+const (
+	DefaultCodespace                = types.DefaultCodespace
+	CodeInvalidToken                = types.CodeInvalidToken
+	CodeTokenExists                 = types.CodeTokenExists
+	CodeTokenNotFound               = types.CodeTokenNotFound
+	CodeNotTokenOwner               = types.CodeNotTokenOwner
+	CodeMintFrozen                  = types.CodeMintFrozen
+	CodeMaxSupplyExceeded           = types.CodeMaxSupplyExceeded
+	EventTypeIssueToken             = types.EventTypeIssueToken
+	EventTypeMintTokens             = types.EventTypeMintTokens
+	EventTypeBurnTokens             = types.EventTypeBurnTokens
+	EventTypeTransferTokenOwnership = types.EventTypeTransferTokenOwnership
+	EventTypeFreezeMint             = types.EventTypeFreezeMint
+	AttributeKeyDenom               = types.AttributeKeyDenom
+	AttributeKeyOwner               = types.AttributeKeyOwner
+	AttributeKeyNewOwner            = types.AttributeKeyNewOwner
+	AttributeKeyRecipient           = types.AttributeKeyRecipient
+	AttributeValueCategory          = types.AttributeValueCategory
+	ModuleName                      = types.ModuleName
+	StoreKey                        = types.StoreKey
+	RouterKey                       = types.RouterKey
+	QuerierRoute                    = types.QuerierRoute
+	TypeMsgIssueToken               = types.TypeMsgIssueToken
+	TypeMsgMintTokens               = types.TypeMsgMintTokens
+	TypeMsgBurnTokens               = types.TypeMsgBurnTokens
+	TypeMsgTransferTokenOwnership   = types.TypeMsgTransferTokenOwnership
+	TypeMsgFreezeMint               = types.TypeMsgFreezeMint
+	QueryToken                      = types.QueryToken
+	QueryTokens                     = types.QueryTokens
+	MaxNameLength                   = types.MaxNameLength
+	MaxDescriptionLength            = types.MaxDescriptionLength
+)
+
+var (
+	// functions aliases
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	RegisterInvariants           = keeper.RegisterInvariants
+	MaxSupplyInvariant           = keeper.MaxSupplyInvariant
+	RegisterCodec                = types.RegisterCodec
+	ErrInvalidToken              = types.ErrInvalidToken
+	ErrTokenExists               = types.ErrTokenExists
+	ErrTokenNotFound             = types.ErrTokenNotFound
+	ErrNotTokenOwner             = types.ErrNotTokenOwner
+	ErrMintFrozen                = types.ErrMintFrozen
+	ErrMaxSupplyExceeded         = types.ErrMaxSupplyExceeded
+	NewGenesisState              = types.NewGenesisState
+	DefaultGenesisState          = types.DefaultGenesisState
+	GetTokenKey                  = types.GetTokenKey
+	NewMsgIssueToken             = types.NewMsgIssueToken
+	NewMsgMintTokens             = types.NewMsgMintTokens
+	NewMsgBurnTokens             = types.NewMsgBurnTokens
+	NewMsgTransferTokenOwnership = types.NewMsgTransferTokenOwnership
+	NewMsgFreezeMint             = types.NewMsgFreezeMint
+	NewQueryTokenParams          = types.NewQueryTokenParams
+	NewToken                     = types.NewToken
+
+	// variable aliases
+	ModuleCdc      = types.ModuleCdc
+	TokenKeyPrefix = types.TokenKeyPrefix
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	GenesisState              = types.GenesisState
+	MsgIssueToken             = types.MsgIssueToken
+	MsgMintTokens             = types.MsgMintTokens
+	MsgBurnTokens             = types.MsgBurnTokens
+	MsgTransferTokenOwnership = types.MsgTransferTokenOwnership
+	MsgFreezeMint             = types.MsgFreezeMint
+	QueryTokenParams          = types.QueryTokenParams
+	Token                     = types.Token
+	Tokens                    = types.Tokens
+)
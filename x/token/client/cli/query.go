@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the token module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	tokenQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the token module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryToken(cdc),
+			GetCmdQueryTokens(cdc),
+		)...,
+	)
+
+	return tokenQueryCmd
+}
+
+// GetCmdQueryToken implements the query token command.
+func GetCmdQueryToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token [denom]",
+		Short: "Query a token by its denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of a user-issued token by its denomination.
+
+Example:
+$ %s query %s token mytoken
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryToken)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var token types.Token
+			if err := cdc.UnmarshalJSON(res, &token); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(token)
+		},
+	}
+}
+
+// GetCmdQueryTokens implements the query tokens command.
+func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens",
+		Short: "Query all the user-issued tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the details of all the user-issued tokens.
+
+Example:
+$ %s query %s tokens
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokens)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tokens types.Tokens
+			if err := cdc.UnmarshalJSON(res, &tokens); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+}
@@ -0,0 +1,226 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+const (
+	flagDescription = "description"
+	flagMaxSupply   = "max-supply"
+)
+
+// GetTxCmd returns the transaction commands for the token module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	tokenTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Token issuance transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenTxCmd.AddCommand(client.PostCommands(
+		GetCmdIssueToken(cdc),
+		GetCmdMintTokens(cdc),
+		GetCmdBurnTokens(cdc),
+		GetCmdTransferTokenOwnership(cdc),
+		GetCmdFreezeMint(cdc),
+	)...)
+
+	return tokenTxCmd
+}
+
+// GetCmdIssueToken implements the issue token command.
+func GetCmdIssueToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue [denom] [name]",
+		Short: "Issue a new token owned by you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Issue a new token with the given denomination and name, owned by the sender.
+The denomination must not be in use already. The supply of the token is
+uncapped unless a --max-supply is given.
+
+Example:
+$ %s tx %s issue mytoken "My Token" --description "A token of mine" --max-supply 1000000 --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			maxSupply := sdk.ZeroInt()
+			if maxSupplyStr := viper.GetString(flagMaxSupply); maxSupplyStr != "" {
+				var ok bool
+				maxSupply, ok = sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply %s", maxSupplyStr)
+				}
+			}
+
+			msg := types.NewMsgIssueToken(cliCtx.GetFromAddress(), args[0], args[1], viper.GetString(flagDescription), maxSupply)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagDescription, "", "The description of the token")
+	cmd.Flags().String(flagMaxSupply, "", "The maximum supply of the token, uncapped if empty")
+
+	return cmd
+}
+
+// GetCmdMintTokens implements the mint tokens command.
+func GetCmdMintTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint [recipient] [amount]",
+		Short: "Mint coins of a token you own to a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint new coins of a token owned by the sender and send them to the recipient.
+
+Example:
+$ %s tx %s mint cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1000mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintTokens(cliCtx.GetFromAddress(), recipient, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBurnTokens implements the burn tokens command.
+func GetCmdBurnTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn coins of a token you own",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn coins of a token owned by the sender out of the sender's balance.
+
+Example:
+$ %s tx %s burn 1000mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnTokens(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTransferTokenOwnership implements the transfer token ownership command.
+func GetCmdTransferTokenOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-ownership [denom] [new-owner]",
+		Short: "Transfer the ownership of a token you own",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer the ownership of a token owned by the sender to a new owner.
+
+Example:
+$ %s tx %s transfer-ownership mytoken cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferTokenOwnership(cliCtx.GetFromAddress(), args[0], newOwner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFreezeMint implements the freeze mint command.
+func GetCmdFreezeMint(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freeze-mint [denom]",
+		Short: "Permanently give up minting a token you own",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Permanently revoke the authority to mint new coins of a token owned by the
+sender. This cannot be undone.
+
+Example:
+$ %s tx %s freeze-mint mytoken --from mykey
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgFreezeMint(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
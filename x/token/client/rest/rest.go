@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+)
+
+// REST query and parameter values
+const (
+	RestParamDenom = "denom"
+)
+
+// RegisterRoutes registers the token module's REST service handlers.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/token/tokens", issueTokenHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/token/tokens/{%s}/mint", RestParamDenom), mintTokensHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/token/tokens/{%s}/burn", RestParamDenom), burnTokensHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/token/tokens/{%s}/ownership", RestParamDenom), transferTokenOwnershipHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/token/tokens/{%s}/freeze-mint", RestParamDenom), freezeMintHandlerFn(cliCtx)).Methods("POST")
+}
+
+type (
+	// IssueTokenReq defines the properties of an issue token request's body.
+	// The owner is the sender of the base request.
+	IssueTokenReq struct {
+		BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Denom       string       `json:"denom" yaml:"denom"`
+		Name        string       `json:"name" yaml:"name"`
+		Description string       `json:"description" yaml:"description"`
+		MaxSupply   sdk.Int      `json:"max_supply" yaml:"max_supply"`
+	}
+
+	// MintTokensReq defines the properties of a mint tokens request's body.
+	MintTokensReq struct {
+		BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		Amount    sdk.Int        `json:"amount" yaml:"amount"`
+	}
+
+	// BurnTokensReq defines the properties of a burn tokens request's body.
+	BurnTokensReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Amount  sdk.Int      `json:"amount" yaml:"amount"`
+	}
+
+	// TransferTokenOwnershipReq defines the properties of a transfer token
+	// ownership request's body.
+	TransferTokenOwnershipReq struct {
+		BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	}
+
+	// FreezeMintReq defines the properties of a freeze mint request's body.
+	FreezeMintReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	}
+)
+
+func issueTokenHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req IssueTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgIssueToken(owner, req.Denom, req.Name, req.Description, req.MaxSupply)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func mintTokensHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)[RestParamDenom]
+
+		var req MintTokensReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgMintTokens(owner, req.Recipient, sdk.Coin{Denom: denom, Amount: req.Amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func burnTokensHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)[RestParamDenom]
+
+		var req BurnTokensReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBurnTokens(owner, sdk.Coin{Denom: denom, Amount: req.Amount})
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func transferTokenOwnershipHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)[RestParamDenom]
+
+		var req TransferTokenOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferTokenOwnership(owner, denom, req.NewOwner)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func freezeMintHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)[RestParamDenom]
+
+		var req FreezeMintReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		owner, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgFreezeMint(owner, denom)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
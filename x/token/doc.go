@@ -0,0 +1,20 @@
+/*
+Package token implements user-issued fungible tokens on top of the supply
+module.
+
+Any account may issue a new token with a MsgIssueToken, registering a
+denomination which is not yet in use along with a name, a description and an
+optional max supply. The issuer becomes the owner of the token and may then:
+
+  - mint new coins of the token to any account with a MsgMintTokens, as long as
+    the total supply stays within the max supply;
+  - burn coins of the token out of its own balance with a MsgBurnTokens;
+  - transfer the ownership of the token with a MsgTransferTokenOwnership;
+  - permanently give up the authority to mint with a MsgFreezeMint.
+
+Coins are minted and burned through the token module account with the
+SupplyKeeper's MintCoins and BurnCoins, so the supply module's total supply
+always reflects the supply of every token. The module account must be given
+the supply.Minter and supply.Burner permissions.
+*/
+package token
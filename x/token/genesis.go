@@ -0,0 +1,29 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// InitGenesis initializes the token module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	// check if the module account exists
+	if moduleAcc := k.GetTokenAccount(ctx); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleName))
+	}
+
+	for _, token := range gs.Tokens {
+		k.SetToken(ctx, token)
+	}
+}
+
+// ExportGenesis returns the token module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetAllTokens(ctx))
+}
@@ -0,0 +1,90 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// NewHandler returns a handler for token messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgIssueToken:
+			return handleMsgIssueToken(ctx, k, msg)
+
+		case MsgMintTokens:
+			return handleMsgMintTokens(ctx, k, msg)
+
+		case MsgBurnTokens:
+			return handleMsgBurnTokens(ctx, k, msg)
+
+		case MsgTransferTokenOwnership:
+			return handleMsgTransferTokenOwnership(ctx, k, msg)
+
+		case MsgFreezeMint:
+			return handleMsgFreezeMint(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgIssueToken(ctx sdk.Context, k Keeper, msg MsgIssueToken) sdk.Result {
+	token := NewToken(msg.Denom, msg.Owner, msg.Name, msg.Description, msg.MaxSupply)
+	if err := k.IssueToken(ctx, token); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Owner)
+}
+
+func handleMsgMintTokens(ctx sdk.Context, k Keeper, msg MsgMintTokens) sdk.Result {
+	if err := k.MintTokens(ctx, msg.Owner, msg.Recipient, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Owner)
+}
+
+func handleMsgBurnTokens(ctx sdk.Context, k Keeper, msg MsgBurnTokens) sdk.Result {
+	if err := k.BurnTokens(ctx, msg.Owner, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Owner)
+}
+
+func handleMsgTransferTokenOwnership(ctx sdk.Context, k Keeper, msg MsgTransferTokenOwnership) sdk.Result {
+	if err := k.TransferTokenOwnership(ctx, msg.Owner, msg.Denom, msg.NewOwner); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Owner)
+}
+
+func handleMsgFreezeMint(ctx sdk.Context, k Keeper, msg MsgFreezeMint) sdk.Result {
+	if err := k.FreezeMint(ctx, msg.Owner, msg.Denom); err != nil {
+		return err.Result()
+	}
+
+	return messageResult(ctx, msg.Owner)
+}
+
+// messageResult emits the message event for a handled message sent by sender
+// and returns the result holding all the events emitted while handling it.
+func messageResult(ctx sdk.Context, sender sdk.AccAddress) sdk.Result {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+// RegisterInvariants registers all token invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+}
+
+// MaxSupplyInvariant checks that the total supply of each token does not
+// exceed its max supply
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		total := k.supplyKeeper.GetSupply(ctx).GetTotal()
+		k.IterateTokens(ctx, func(token types.Token) bool {
+			supply := total.AmountOf(token.Denom)
+			if token.HasMaxSupply() && supply.GT(token.MaxSupply) {
+				broken = true
+				msg += fmt.Sprintf("\ttoken %s supply %s exceeds its max supply %s\n", token.Denom, supply, token.MaxSupply)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "max supply", msg), broken
+	}
+}
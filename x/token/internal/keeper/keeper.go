@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	supplyexported "github.com/hyperspeednetwork/hsnhub/x/supply/exported"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+// Keeper defines the token module's keeper. The keeper manages the tokens
+// issued by users and mints and burns their coins through the token module
+// account, so that the total supply is kept by the supply module.
+type Keeper struct {
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	supplyKeeper types.SupplyKeeper
+	bankKeeper   types.BankKeeper
+	codespace    sdk.CodespaceType
+}
+
+// NewKeeper creates a new token Keeper instance
+func NewKeeper(
+	cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, bankKeeper types.BankKeeper,
+	codespace sdk.CodespaceType,
+) Keeper {
+
+	// ensure the token module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		supplyKeeper: supplyKeeper,
+		bankKeeper:   bankKeeper,
+		codespace:    codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// Codespace returns the token module's codespace.
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetTokenAccount returns the token ModuleAccount, through which the coins of
+// all tokens are minted and burned.
+func (k Keeper) GetTokenAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// IssueToken registers a new token. The denomination of the token must not
+// be registered, have any supply already or have metadata in x/supply, which
+// marks the denominations of the chain and its modules.
+func (k Keeper) IssueToken(ctx sdk.Context, token types.Token) sdk.Error {
+	if _, found := k.GetToken(ctx, token.Denom); found {
+		return types.ErrTokenExists(k.codespace, token.Denom)
+	}
+	if !k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(token.Denom).IsZero() {
+		return types.ErrTokenExists(k.codespace, token.Denom)
+	}
+	// the denominations registered in the supply metadata belong to the chain
+	if _, found := k.supplyKeeper.GetDenomMetadata(ctx, token.Denom); found {
+		return types.ErrTokenExists(k.codespace, token.Denom)
+	}
+
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIssueToken,
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, token.Owner.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(fmt.Sprintf("issued token %s owned by %s", token.Denom, token.Owner))
+	return nil
+}
+
+// MintTokens mints the given amount of a token owned by owner and sends it to
+// the recipient, which cannot be a blacklisted module account.
+func (k Keeper) MintTokens(ctx sdk.Context, owner, recipient sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	token, err := k.getOwnedToken(ctx, owner, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlacklistedAddr(recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", recipient))
+	}
+
+	if token.MintFrozen {
+		return types.ErrMintFrozen(k.codespace, token.Denom)
+	}
+
+	if token.HasMaxSupply() {
+		supply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(token.Denom)
+		if supply.Add(amount.Amount).GT(token.MaxSupply) {
+			return types.ErrMaxSupplyExceeded(k.codespace, token.Denom, token.MaxSupply)
+		}
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintTokens,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		),
+	)
+
+	return nil
+}
+
+// BurnTokens burns the given amount of a token owned by owner out of the
+// owner's balance.
+func (k Keeper) BurnTokens(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if _, err := k.getOwnedToken(ctx, owner, amount.Denom); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnTokens,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// TransferTokenOwnership transfers the ownership of a token owned by owner to
+// newOwner.
+func (k Keeper) TransferTokenOwnership(ctx sdk.Context, owner sdk.AccAddress, denom string, newOwner sdk.AccAddress) sdk.Error {
+	token, err := k.getOwnedToken(ctx, owner, denom)
+	if err != nil {
+		return err
+	}
+
+	token.Owner = newOwner
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferTokenOwnership,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner.String()),
+		),
+	)
+
+	return nil
+}
+
+// FreezeMint permanently revokes the authority to mint new coins of a token
+// owned by owner.
+func (k Keeper) FreezeMint(ctx sdk.Context, owner sdk.AccAddress, denom string) sdk.Error {
+	token, err := k.getOwnedToken(ctx, owner, denom)
+	if err != nil {
+		return err
+	}
+
+	if token.MintFrozen {
+		return types.ErrMintFrozen(k.codespace, denom)
+	}
+
+	token.MintFrozen = true
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeMint,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return nil
+}
+
+// getOwnedToken returns the token with the given denomination, failing if it
+// does not exist or is not owned by owner.
+func (k Keeper) getOwnedToken(ctx sdk.Context, owner sdk.AccAddress, denom string) (types.Token, sdk.Error) {
+	token, found := k.GetToken(ctx, denom)
+	if !found {
+		return token, types.ErrTokenNotFound(k.codespace, denom)
+	}
+	if !token.Owner.Equals(owner) {
+		return token, types.ErrNotTokenOwner(k.codespace, denom, owner)
+	}
+
+	return token, nil
+}
+
+// GetToken returns the token with the given denomination, if any.
+func (k Keeper) GetToken(ctx sdk.Context, denom string) (token types.Token, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenKey(denom))
+	if bz == nil {
+		return token, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &token)
+	return token, true
+}
+
+// SetToken stores the token.
+func (k Keeper) SetToken(ctx sdk.Context, token types.Token) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(token)
+	store.Set(types.GetTokenKey(token.Denom), bz)
+}
+
+// IterateTokens iterates over all the stored tokens. For each token, cb will
+// be called. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateTokens(ctx sdk.Context, cb func(types.Token) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.Token
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &token)
+
+		if cb(token) {
+			break
+		}
+	}
+}
+
+// GetAllTokens returns all the stored tokens.
+func (k Keeper) GetAllTokens(ctx sdk.Context) []types.Token {
+	tokens := []types.Token{}
+	k.IterateTokens(ctx, func(token types.Token) bool {
+		tokens = append(tokens, token)
+		return false
+	})
+
+	return tokens
+}
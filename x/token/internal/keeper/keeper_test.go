@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/bank"
+	"github.com/hyperspeednetwork/hsnhub/x/params"
+	"github.com/hyperspeednetwork/hsnhub/x/supply"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+var (
+	ownerAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func createTestInput(t *testing.T) (sdk.Context, auth.AccountKeeper, supply.Keeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyToken := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyToken, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "token-chain"}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	blacklistedAddrs := map[string]bool{
+		supply.NewModuleAddress(types.ModuleName).String(): true,
+	}
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+
+	maccPerms := map[string][]string{
+		types.ModuleName: {supply.Minter, supply.Burner},
+	}
+	sk := supply.NewKeeper(cdc, keySupply, ak, bk, maccPerms)
+
+	initialCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	acc := ak.NewAccountWithAddress(ctx, ownerAddr)
+	require.NoError(t, acc.SetCoins(initialCoins))
+	ak.SetAccount(ctx, acc)
+	sk.SetSupply(ctx, supply.NewSupply(initialCoins))
+
+	k := NewKeeper(cdc, keyToken, sk, bk, types.DefaultCodespace)
+
+	return ctx, ak, sk, k
+}
+
+func TestIssueToken(t *testing.T) {
+	ctx, _, sk, k := createTestInput(t)
+
+	token := types.NewToken("mytoken", ownerAddr, "My Token", "", sdk.ZeroInt())
+	require.NoError(t, k.IssueToken(ctx, token))
+
+	got, found := k.GetToken(ctx, "mytoken")
+	require.True(t, found)
+	require.Equal(t, token, got)
+
+	// denominations cannot be issued twice
+	require.Error(t, k.IssueToken(ctx, types.NewToken("mytoken", otherAddr, "Other Token", "", sdk.ZeroInt())))
+
+	// denominations which already have a supply cannot be issued
+	require.Error(t, k.IssueToken(ctx, types.NewToken(sdk.DefaultBondDenom, ownerAddr, "Stake", "", sdk.ZeroInt())))
+
+	// denominations with metadata belong to the chain
+	sk.SetDenomMetadata(ctx, supply.NewMetadata("The fee token", "fee", "fee", nil))
+	require.Error(t, k.IssueToken(ctx, types.NewToken("fee", ownerAddr, "Fee", "", sdk.ZeroInt())))
+
+	require.Len(t, k.GetAllTokens(ctx), 1)
+}
+
+func TestMintAndBurnTokens(t *testing.T) {
+	ctx, ak, sk, k := createTestInput(t)
+
+	token := types.NewToken("mytoken", ownerAddr, "My Token", "", sdk.NewInt(100))
+	require.NoError(t, k.IssueToken(ctx, token))
+
+	// only the owner may mint
+	require.Error(t, k.MintTokens(ctx, otherAddr, otherAddr, sdk.NewInt64Coin("mytoken", 10)))
+	require.Error(t, k.MintTokens(ctx, ownerAddr, otherAddr, sdk.NewInt64Coin("unknown", 10)))
+
+	// module accounts cannot receive minted tokens
+	err := k.MintTokens(ctx, ownerAddr, k.GetTokenAccount(ctx).GetAddress(), sdk.NewInt64Coin("mytoken", 10))
+	require.Error(t, err)
+	require.Equal(t, sdk.CodeUnauthorized, err.Code())
+
+	require.NoError(t, k.MintTokens(ctx, ownerAddr, otherAddr, sdk.NewInt64Coin("mytoken", 60)))
+	require.NoError(t, k.MintTokens(ctx, ownerAddr, ownerAddr, sdk.NewInt64Coin("mytoken", 30)))
+	require.Equal(t, sdk.NewInt(60), ak.GetAccount(ctx, otherAddr).GetCoins().AmountOf("mytoken"))
+	require.Equal(t, sdk.NewInt(30), ak.GetAccount(ctx, ownerAddr).GetCoins().AmountOf("mytoken"))
+	require.Equal(t, sdk.NewInt(90), sk.GetSupply(ctx).GetTotal().AmountOf("mytoken"))
+	require.True(t, k.GetTokenAccount(ctx).GetCoins().Empty())
+
+	// the supply cannot exceed the max supply
+	err = k.MintTokens(ctx, ownerAddr, ownerAddr, sdk.NewInt64Coin("mytoken", 11))
+	require.Error(t, err)
+	require.Equal(t, types.CodeMaxSupplyExceeded, err.Code())
+
+	// burning makes room for minting again
+	require.Error(t, k.BurnTokens(ctx, otherAddr, sdk.NewInt64Coin("mytoken", 10)))
+	require.Error(t, k.BurnTokens(ctx, ownerAddr, sdk.NewInt64Coin("mytoken", 31)))
+	require.NoError(t, k.BurnTokens(ctx, ownerAddr, sdk.NewInt64Coin("mytoken", 20)))
+	require.Equal(t, sdk.NewInt(10), ak.GetAccount(ctx, ownerAddr).GetCoins().AmountOf("mytoken"))
+	require.Equal(t, sdk.NewInt(70), sk.GetSupply(ctx).GetTotal().AmountOf("mytoken"))
+	require.NoError(t, k.MintTokens(ctx, ownerAddr, ownerAddr, sdk.NewInt64Coin("mytoken", 30)))
+
+	_, broken := MaxSupplyInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestTransferOwnershipAndFreezeMint(t *testing.T) {
+	ctx, _, _, k := createTestInput(t)
+
+	require.NoError(t, k.IssueToken(ctx, types.NewToken("mytoken", ownerAddr, "My Token", "", sdk.ZeroInt())))
+
+	require.Error(t, k.TransferTokenOwnership(ctx, otherAddr, "mytoken", otherAddr))
+	require.NoError(t, k.TransferTokenOwnership(ctx, ownerAddr, "mytoken", otherAddr))
+
+	token, _ := k.GetToken(ctx, "mytoken")
+	require.Equal(t, otherAddr, token.Owner)
+
+	// the previous owner lost the mint authority
+	require.Error(t, k.MintTokens(ctx, ownerAddr, ownerAddr, sdk.NewInt64Coin("mytoken", 10)))
+	require.NoError(t, k.MintTokens(ctx, otherAddr, otherAddr, sdk.NewInt64Coin("mytoken", 10)))
+
+	require.Error(t, k.FreezeMint(ctx, ownerAddr, "mytoken"))
+	require.NoError(t, k.FreezeMint(ctx, otherAddr, "mytoken"))
+	require.Error(t, k.FreezeMint(ctx, otherAddr, "mytoken"))
+
+	err := k.MintTokens(ctx, otherAddr, ownerAddr, sdk.NewInt64Coin("mytoken", 10))
+	require.Error(t, err)
+	require.Equal(t, types.CodeMintFrozen, err.Code())
+
+	// burning is still possible once minting is frozen
+	require.NoError(t, k.BurnTokens(ctx, otherAddr, sdk.NewInt64Coin("mytoken", 10)))
+}
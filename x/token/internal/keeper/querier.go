@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+// NewQuerier creates a querier for token cli and REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryToken:
+			return queryToken(ctx, req, k)
+
+		case types.QueryTokens:
+			return queryTokens(ctx, k)
+
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown token query endpoint: %s", path[0]))
+		}
+	}
+}
+
+func queryToken(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	token, found := k.GetToken(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrTokenNotFound(k.codespace, params.Denom)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, token)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryTokens(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetAllTokens(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal JSON", err.Error()))
+	}
+
+	return res, nil
+}
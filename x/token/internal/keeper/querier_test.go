@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/token/internal/types"
+)
+
+func TestQueryTokens(t *testing.T) {
+	ctx, _, _, k := createTestInput(t)
+	querier := NewQuerier(k)
+
+	token := types.NewToken("mytoken", ownerAddr, "My Token", "Mine", sdk.NewInt(100))
+	require.NoError(t, k.IssueToken(ctx, token))
+	require.NoError(t, k.IssueToken(ctx, types.NewToken("other", otherAddr, "Other Token", "", sdk.ZeroInt())))
+
+	req := abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryTokenParams("mytoken")),
+	}
+	bz, err := querier(ctx, []string{types.QueryToken}, req)
+	require.NoError(t, err)
+
+	var got types.Token
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &got))
+	require.Equal(t, token, got)
+
+	req = abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryTokenParams("unknown")),
+	}
+	_, err = querier(ctx, []string{types.QueryToken}, req)
+	require.Error(t, err)
+
+	bz, err = querier(ctx, []string{types.QueryTokens}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var tokens []types.Token
+	require.NoError(t, k.cdc.UnmarshalJSON(bz, &tokens))
+	require.Len(t, tokens, 2)
+
+	_, err = querier(ctx, []string{"unknown"}, req)
+	require.Error(t, err)
+}
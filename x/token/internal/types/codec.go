@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/codec"
+)
+
+// ModuleCdc defines the token module's codec.
+var ModuleCdc = codec.New()
+
+// RegisterCodec registers all the necessary types and interfaces for the
+// token module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgIssueToken{}, "cosmos-sdk/MsgIssueToken", nil)
+	cdc.RegisterConcrete(MsgMintTokens{}, "cosmos-sdk/MsgMintTokens", nil)
+	cdc.RegisterConcrete(MsgBurnTokens{}, "cosmos-sdk/MsgBurnTokens", nil)
+	cdc.RegisterConcrete(MsgTransferTokenOwnership{}, "cosmos-sdk/MsgTransferTokenOwnership", nil)
+	cdc.RegisterConcrete(MsgFreezeMint{}, "cosmos-sdk/MsgFreezeMint", nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidToken      sdk.CodeType = 1
+	CodeTokenExists       sdk.CodeType = 2
+	CodeTokenNotFound     sdk.CodeType = 3
+	CodeNotTokenOwner     sdk.CodeType = 4
+	CodeMintFrozen        sdk.CodeType = 5
+	CodeMaxSupplyExceeded sdk.CodeType = 6
+)
+
+// ErrInvalidToken returns a typed error when a token is invalid
+func ErrInvalidToken(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidToken, fmt.Sprintf("invalid token: %s", msg))
+}
+
+// ErrTokenExists returns a typed error when the denomination of a token to be
+// issued is already in use
+func ErrTokenExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenExists, fmt.Sprintf("denomination %s is already in use", denom))
+}
+
+// ErrTokenNotFound returns a typed error when no token exists for the given
+// denomination
+func ErrTokenNotFound(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenNotFound, fmt.Sprintf("token %s does not exist", denom))
+}
+
+// ErrNotTokenOwner returns a typed error when an account other than the owner
+// of a token tries to manage it
+func ErrNotTokenOwner(codespace sdk.CodespaceType, denom string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNotTokenOwner, fmt.Sprintf("%s is not the owner of token %s", addr, denom))
+}
+
+// ErrMintFrozen returns a typed error when minting a token whose mint
+// authority has been frozen
+func ErrMintFrozen(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeMintFrozen, fmt.Sprintf("minting of token %s is frozen", denom))
+}
+
+// ErrMaxSupplyExceeded returns a typed error when minting would take the
+// supply of a token over its maximum supply
+func ErrMaxSupplyExceeded(codespace sdk.CodespaceType, denom string, maxSupply sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxSupplyExceeded,
+		fmt.Sprintf("minting would exceed the max supply %s of token %s", maxSupply, denom))
+}
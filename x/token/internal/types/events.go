@@ -0,0 +1,17 @@
+package types
+
+// token module event types
+const (
+	EventTypeIssueToken             = "issue_token"
+	EventTypeMintTokens             = "mint_tokens"
+	EventTypeBurnTokens             = "burn_tokens"
+	EventTypeTransferTokenOwnership = "transfer_token_ownership"
+	EventTypeFreezeMint             = "freeze_mint"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyNewOwner  = "new_owner"
+	AttributeKeyRecipient = "recipient"
+
+	AttributeValueCategory = ModuleName
+)
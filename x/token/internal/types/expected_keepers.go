@@ -0,0 +1,25 @@
+package types // noalias
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/supply"
+	supplyexported "github.com/hyperspeednetwork/hsnhub/x/supply/exported"
+)
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	GetDenomMetadata(ctx sdk.Context, denom string) (supply.Metadata, bool)
+
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+}
+
+// BankKeeper defines the expected bank keeper
+type BankKeeper interface {
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState defines the token module's genesis state.
+type GenesisState struct {
+	Tokens []Token `json:"tokens" yaml:"tokens"`
+}
+
+// NewGenesisState creates a new genesis state for the token module.
+func NewGenesisState(tokens []Token) GenesisState {
+	return GenesisState{Tokens: tokens}
+}
+
+// DefaultGenesisState returns the token module's default genesis state.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Tokens: []Token{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, token := range gs.Tokens {
+		if seenDenoms[token.Denom] {
+			return fmt.Errorf("duplicate token %s", token.Denom)
+		}
+		if err := token.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenDenoms[token.Denom] = true
+	}
+
+	return nil
+}
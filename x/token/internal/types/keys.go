@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "token"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// Keys for token store
+// Items are stored with the following key: values
+//
+// - 0x00<denom_Bytes>: Token
+var (
+	TokenKeyPrefix = []byte{0x00}
+)
+
+// GetTokenKey returns the key of the token with the given denomination
+func GetTokenKey(denom string) []byte {
+	return append(TokenKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,236 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// Message types for the token module
+const (
+	TypeMsgIssueToken             = "issue_token"
+	TypeMsgMintTokens             = "mint_tokens"
+	TypeMsgBurnTokens             = "burn_tokens"
+	TypeMsgTransferTokenOwnership = "transfer_token_ownership"
+	TypeMsgFreezeMint             = "freeze_mint"
+)
+
+var (
+	_ sdk.Msg = MsgIssueToken{}
+	_ sdk.Msg = MsgMintTokens{}
+	_ sdk.Msg = MsgBurnTokens{}
+	_ sdk.Msg = MsgTransferTokenOwnership{}
+	_ sdk.Msg = MsgFreezeMint{}
+)
+
+// MsgIssueToken registers a new token owned by the sender. A zero max supply
+// leaves the supply of the token uncapped.
+type MsgIssueToken struct {
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom       string         `json:"denom" yaml:"denom"`
+	Name        string         `json:"name" yaml:"name"`
+	Description string         `json:"description" yaml:"description"`
+	MaxSupply   sdk.Int        `json:"max_supply" yaml:"max_supply"`
+}
+
+// NewMsgIssueToken creates a new MsgIssueToken object.
+func NewMsgIssueToken(owner sdk.AccAddress, denom, name, description string, maxSupply sdk.Int) MsgIssueToken {
+	return MsgIssueToken{
+		Owner:       owner,
+		Denom:       denom,
+		Name:        name,
+		Description: description,
+		MaxSupply:   maxSupply,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgIssueToken) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgIssueToken) Type() string { return TypeMsgIssueToken }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgIssueToken) ValidateBasic() sdk.Error {
+	return NewToken(msg.Denom, msg.Owner, msg.Name, msg.Description, msg.MaxSupply).ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgIssueToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgIssueToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgMintTokens mints new coins of a token owned by the sender and sends them
+// to the recipient.
+type MsgMintTokens struct {
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgMintTokens creates a new MsgMintTokens object.
+func NewMsgMintTokens(owner, recipient sdk.AccAddress, amount sdk.Coin) MsgMintTokens {
+	return MsgMintTokens{Owner: owner, Recipient: recipient, Amount: amount}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgMintTokens) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgMintTokens) Type() string { return TypeMsgMintTokens }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMintTokens) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgMintTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgMintTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgBurnTokens burns coins of a token owned by the sender out of the sender's
+// balance.
+type MsgBurnTokens struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBurnTokens creates a new MsgBurnTokens object.
+func NewMsgBurnTokens(owner sdk.AccAddress, amount sdk.Coin) MsgBurnTokens {
+	return MsgBurnTokens{Owner: owner, Amount: amount}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgBurnTokens) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgBurnTokens) Type() string { return TypeMsgBurnTokens }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgBurnTokens) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgBurnTokens) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgBurnTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTransferTokenOwnership transfers the ownership of a token owned by the
+// sender to a new owner.
+type MsgTransferTokenOwnership struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom    string         `json:"denom" yaml:"denom"`
+	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+}
+
+// NewMsgTransferTokenOwnership creates a new MsgTransferTokenOwnership object.
+func NewMsgTransferTokenOwnership(owner sdk.AccAddress, denom string, newOwner sdk.AccAddress) MsgTransferTokenOwnership {
+	return MsgTransferTokenOwnership{Owner: owner, Denom: denom, NewOwner: newOwner}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTransferTokenOwnership) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTransferTokenOwnership) Type() string { return TypeMsgTransferTokenOwnership }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTransferTokenOwnership) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if msg.NewOwner.Empty() {
+		return sdk.ErrInvalidAddress("missing new owner address")
+	}
+	if msg.Owner.Equals(msg.NewOwner) {
+		return ErrInvalidToken(DefaultCodespace, "new owner is the current owner")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrInvalidToken(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTransferTokenOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTransferTokenOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgFreezeMint permanently revokes the owner's authority to mint new coins of
+// a token, fixing its supply to what has been minted so far less what is
+// burned.
+type MsgFreezeMint struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom string         `json:"denom" yaml:"denom"`
+}
+
+// NewMsgFreezeMint creates a new MsgFreezeMint object.
+func NewMsgFreezeMint(owner sdk.AccAddress, denom string) MsgFreezeMint {
+	return MsgFreezeMint{Owner: owner, Denom: denom}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgFreezeMint) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgFreezeMint) Type() string { return TypeMsgFreezeMint }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgFreezeMint) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrInvalidToken(DefaultCodespace, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgFreezeMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgFreezeMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestMsgIssueToken(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgIssueToken
+	}{
+		{true, NewMsgIssueToken(owner, "mytoken", "My Token", "", sdk.ZeroInt())},
+		{true, NewMsgIssueToken(owner, "mytoken", "My Token", "Mine", sdk.NewInt(100))},
+		{false, NewMsgIssueToken(emptyAddr, "mytoken", "My Token", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "MyToken", "My Token", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "t", "My Token", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "sh44c91e3b5e0cbd", "My Token", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "mytokenwithalongname", "My Token", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "mytoken", " ", "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "mytoken", strings.Repeat("a", MaxNameLength+1), "", sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "mytoken", "My Token", strings.Repeat("a", MaxDescriptionLength+1), sdk.ZeroInt())},
+		{false, NewMsgIssueToken(owner, "mytoken", "My Token", "", sdk.NewInt(-1))},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+
+	msg := NewMsgIssueToken(owner, "mytoken", "My Token", "", sdk.ZeroInt())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgIssueToken, msg.Type())
+	require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+}
+
+func TestMsgMintAndBurnTokens(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amount := sdk.NewInt64Coin("mytoken", 10)
+
+	var emptyAddr sdk.AccAddress
+
+	require.Nil(t, NewMsgMintTokens(owner, recipient, amount).ValidateBasic())
+	require.NotNil(t, NewMsgMintTokens(emptyAddr, recipient, amount).ValidateBasic())
+	require.NotNil(t, NewMsgMintTokens(owner, emptyAddr, amount).ValidateBasic())
+	require.NotNil(t, NewMsgMintTokens(owner, recipient, sdk.NewInt64Coin("mytoken", 0)).ValidateBasic())
+
+	require.Nil(t, NewMsgBurnTokens(owner, amount).ValidateBasic())
+	require.NotNil(t, NewMsgBurnTokens(emptyAddr, amount).ValidateBasic())
+	require.NotNil(t, NewMsgBurnTokens(owner, sdk.NewInt64Coin("mytoken", 0)).ValidateBasic())
+}
+
+func TestMsgTransferTokenOwnershipAndFreezeMint(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner"))
+	newOwner := sdk.AccAddress([]byte("newOwner"))
+
+	var emptyAddr sdk.AccAddress
+
+	require.Nil(t, NewMsgTransferTokenOwnership(owner, "mytoken", newOwner).ValidateBasic())
+	require.NotNil(t, NewMsgTransferTokenOwnership(emptyAddr, "mytoken", newOwner).ValidateBasic())
+	require.NotNil(t, NewMsgTransferTokenOwnership(owner, "mytoken", emptyAddr).ValidateBasic())
+	require.NotNil(t, NewMsgTransferTokenOwnership(owner, "mytoken", owner).ValidateBasic())
+	require.NotNil(t, NewMsgTransferTokenOwnership(owner, "", newOwner).ValidateBasic())
+
+	require.Nil(t, NewMsgFreezeMint(owner, "mytoken").ValidateBasic())
+	require.NotNil(t, NewMsgFreezeMint(emptyAddr, "mytoken").ValidateBasic())
+	require.NotNil(t, NewMsgFreezeMint(owner, "").ValidateBasic())
+}
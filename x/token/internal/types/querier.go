@@ -0,0 +1,17 @@
+package types
+
+// Querier routes for the token module
+const (
+	QueryToken  = "token"
+	QueryTokens = "tokens"
+)
+
+// QueryTokenParams defines the parameters necessary for querying a token.
+type QueryTokenParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryTokenParams creates a new QueryTokenParams object
+func NewQueryTokenParams(denom string) QueryTokenParams {
+	return QueryTokenParams{Denom: denom}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/staking"
+)
+
+// Maximum lengths of a token's metadata
+const (
+	MaxNameLength        = 64
+	MaxDescriptionLength = 256
+)
+
+// Token defines a user-issued fungible token. The owner of a token may mint
+// new coins of its denomination, up to MaxSupply unless it is zero, until
+// minting is frozen. A token without a max supply is uncapped.
+type Token struct {
+	Denom       string         `json:"denom" yaml:"denom"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	Name        string         `json:"name" yaml:"name"`
+	Description string         `json:"description" yaml:"description"`
+	MaxSupply   sdk.Int        `json:"max_supply" yaml:"max_supply"`
+	MintFrozen  bool           `json:"mint_frozen" yaml:"mint_frozen"`
+}
+
+// NewToken creates a new Token object
+func NewToken(denom string, owner sdk.AccAddress, name, description string, maxSupply sdk.Int) Token {
+	return Token{
+		Denom:       denom,
+		Owner:       owner,
+		Name:        name,
+		Description: description,
+		MaxSupply:   maxSupply,
+	}
+}
+
+// HasMaxSupply returns true if the supply of the token is capped.
+func (t Token) HasMaxSupply() bool {
+	return !t.MaxSupply.IsNil() && t.MaxSupply.IsPositive()
+}
+
+// ValidateBasic performs basic validation of the token.
+func (t Token) ValidateBasic() sdk.Error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return ErrInvalidToken(DefaultCodespace, err.Error())
+	}
+	if staking.IsShareDenom(t.Denom) {
+		return ErrInvalidToken(DefaultCodespace, fmt.Sprintf("denom %s is reserved to modules", t.Denom))
+	}
+	if t.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(strings.TrimSpace(t.Name)) == 0 {
+		return ErrInvalidToken(DefaultCodespace, "name cannot be blank")
+	}
+	if len(t.Name) > MaxNameLength {
+		return ErrInvalidToken(DefaultCodespace, fmt.Sprintf("name is longer than %d characters", MaxNameLength))
+	}
+	if len(t.Description) > MaxDescriptionLength {
+		return ErrInvalidToken(DefaultCodespace, fmt.Sprintf("description is longer than %d characters", MaxDescriptionLength))
+	}
+	if !t.MaxSupply.IsNil() && t.MaxSupply.IsNegative() {
+		return ErrInvalidToken(DefaultCodespace, "max supply cannot be negative")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (t Token) String() string {
+	return fmt.Sprintf(`Token:
+  Denom:       %s
+  Owner:       %s
+  Name:        %s
+  Description: %s
+  Max Supply:  %s
+  Mint Frozen: %t`,
+		t.Denom, t.Owner, t.Name, t.Description, t.MaxSupply, t.MintFrozen,
+	)
+}
+
+// Tokens defines a list of tokens.
+type Tokens []Token
+
+// String implements the Stringer interface.
+func (ts Tokens) String() (out string) {
+	for _, t := range ts {
+		out += t.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestTokenWithoutMaxSupply(t *testing.T) {
+	owner := sdk.AccAddress([]byte("token_owner_address_"))
+
+	bz, err := ModuleCdc.MarshalJSON(struct {
+		Denom string         `json:"denom"`
+		Owner sdk.AccAddress `json:"owner"`
+		Name  string         `json:"name"`
+	}{"mytoken", owner, "My Token"})
+	require.NoError(t, err)
+
+	var token Token
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &token))
+	require.True(t, token.MaxSupply.IsNil())
+
+	require.Nil(t, token.ValidateBasic())
+	require.False(t, token.HasMaxSupply())
+	require.NotPanics(t, func() { _ = token.String() })
+
+	msg := NewMsgIssueToken(token.Owner, token.Denom, token.Name, token.Description, token.MaxSupply)
+	require.Nil(t, msg.ValidateBasic())
+}
@@ -0,0 +1,208 @@
+package upgrade_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade"
+)
+
+type testInput struct {
+	ctx     sdk.Context
+	keeper  upgrade.Keeper
+	handler govtypes.Handler
+	home    string
+}
+
+func setupTest(t *testing.T, height int64) testInput {
+	home, err := ioutil.TempDir("", "upgrade-test")
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(upgrade.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	upgrade.RegisterCodec(cdc)
+
+	k := upgrade.NewKeeper(key, cdc, home)
+	ctx := sdk.NewContext(ms, abci.Header{Height: height, Time: time.Now()}, false, log.NewNopLogger())
+
+	return testInput{
+		ctx:     ctx,
+		keeper:  k,
+		handler: upgrade.NewSoftwareUpgradeProposalHandler(k),
+		home:    home,
+	}
+}
+
+func (ti testInput) nextBlock() testInput {
+	header := ti.ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(time.Second)
+	ti.ctx = ti.ctx.WithBlockHeader(header)
+	return ti
+}
+
+func beginBlock(ti testInput) {
+	upgrade.BeginBlocker(ti.keeper, ti.ctx, abci.RequestBeginBlock{Header: ti.ctx.BlockHeader()})
+}
+
+func TestRequireName(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	err := ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", upgrade.Plan{Height: 20}))
+	require.Error(t, err)
+}
+
+func TestCantSetBothTimeAndHeight(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	plan := upgrade.NewPlan("test", time.Now().Add(time.Hour), 20, "")
+	err := ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan))
+	require.Error(t, err)
+}
+
+func TestCantScheduleInThePast(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	err := ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", upgrade.Plan{Name: "test", Height: 10}))
+	require.Error(t, err)
+
+	plan := upgrade.Plan{Name: "test", Time: ti.ctx.BlockTime().Add(-time.Hour)}
+	err = ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan))
+	require.Error(t, err)
+}
+
+func TestHaltIfNoHandlerAtHeight(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	plan := upgrade.Plan{Name: "test", Height: 11, Info: "commit abcdef"}
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+
+	// nothing happens before the upgrade height
+	require.NotPanics(t, func() { beginBlock(ti) })
+
+	ti = ti.nextBlock()
+	require.Panics(t, func() { beginBlock(ti) })
+
+	// the upgrade info file is written before halting
+	bz, err := ioutil.ReadFile(filepath.Join(ti.home, "data", upgrade.UpgradeInfoFileName))
+	require.NoError(t, err)
+
+	var info upgrade.UpgradeInfo
+	require.NoError(t, json.Unmarshal(bz, &info))
+	require.Equal(t, upgrade.UpgradeInfo{Name: "test", Height: 11, Info: "commit abcdef"}, info)
+
+	read, err := ti.keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, info, read)
+}
+
+func TestHaltIfNoHandlerAtTime(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	plan := upgrade.Plan{Name: "test", Time: ti.ctx.BlockTime().Add(time.Second)}
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+
+	require.NotPanics(t, func() { beginBlock(ti) })
+
+	ti = ti.nextBlock()
+	require.Panics(t, func() { beginBlock(ti) })
+}
+
+func TestHandlerRegisteredTooEarly(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	plan := upgrade.Plan{Name: "test", Height: 12}
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+
+	ti.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) {})
+	require.Panics(t, func() { beginBlock(ti) })
+}
+
+func TestApplyUpgrade(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	plan := upgrade.Plan{Name: "test", Height: 11}
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+
+	called := 0
+	ti = ti.nextBlock()
+	ti.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) { called++ })
+	require.NotPanics(t, func() { beginBlock(ti) })
+	require.Equal(t, 1, called)
+
+	// the plan is cleared and recorded as done
+	_, found := ti.keeper.GetUpgradePlan(ti.ctx)
+	require.False(t, found)
+	require.Equal(t, int64(11), ti.keeper.GetDoneHeight(ti.ctx, "test"))
+
+	// following blocks run normally
+	ti = ti.nextBlock()
+	require.NotPanics(t, func() { beginBlock(ti) })
+	require.Equal(t, 1, called)
+
+	// a completed upgrade name cannot be reused
+	plan = upgrade.Plan{Name: "test", Height: 20}
+	require.Error(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	// nothing to cancel
+	err := ti.handler(ti.ctx, upgrade.NewCancelSoftwareUpgradeProposal("cancel", "cancel"))
+	require.Error(t, err)
+	require.Equal(t, upgrade.CodeNoUpgradePlan, err.Code())
+
+	plan := upgrade.Plan{Name: "test", Height: 11}
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", plan)))
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewCancelSoftwareUpgradeProposal("cancel", "cancel")))
+
+	_, found := ti.keeper.GetUpgradePlan(ti.ctx)
+	require.False(t, found)
+
+	ti = ti.nextBlock()
+	require.NotPanics(t, func() { beginBlock(ti) })
+}
+
+func TestNewPlanOverridesOld(t *testing.T) {
+	ti := setupTest(t, 10)
+	defer os.RemoveAll(ti.home)
+
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", upgrade.Plan{Name: "first", Height: 11})))
+	require.NoError(t, ti.handler(ti.ctx, upgrade.NewSoftwareUpgradeProposal("prop", "prop", upgrade.Plan{Name: "second", Height: 12})))
+
+	plan, found := ti.keeper.GetUpgradePlan(ti.ctx)
+	require.True(t, found)
+	require.Equal(t, "second", plan.Name)
+
+	ti = ti.nextBlock()
+	require.NotPanics(t, func() { beginBlock(ti) })
+}
@@ -0,0 +1,65 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/keeper
+// ALIASGEN: github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types
+package upgrade
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/keeper"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
+	QuerierRoute                      = types.QuerierRoute
+	PlanByte                          = types.PlanByte
+	DoneByte                          = types.DoneByte
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidPlan                   = types.CodeInvalidPlan
+	CodeUpgradeNotApplied             = types.CodeUpgradeNotApplied
+	CodeNoUpgradePlan                 = types.CodeNoUpgradePlan
+	UpgradeInfoFileName               = types.UpgradeInfoFileName
+	EventTypeUpgrade                  = types.EventTypeUpgrade
+	AttributeKeyName                  = types.AttributeKeyName
+	AttributeKeyHeight                = types.AttributeKeyHeight
+	AttributeValueCategory            = types.AttributeValueCategory
+)
+
+var (
+	// functions aliases
+	RegisterCodec                    = types.RegisterCodec
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrNoUpgradePlan                 = types.ErrNoUpgradePlan
+	ErrUpgradeNotApplied             = types.ErrUpgradeNotApplied
+	PlanKey                          = types.PlanKey
+	DoneKey                          = types.DoneKey
+	NewPlan                          = types.NewPlan
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	NewAppliedUpgrade                = types.NewAppliedUpgrade
+	UpgradeStoreLoader               = types.UpgradeStoreLoader
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+
+	// variable aliases
+	ModuleCdc = types.ModuleCdc
+)
+
+type (
+	UpgradeHandler                = types.UpgradeHandler
+	Plan                          = types.Plan
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+	QueryAppliedParams            = types.QueryAppliedParams
+	AppliedUpgrade                = types.AppliedUpgrade
+	UpgradeInfo                   = types.UpgradeInfo
+	Keeper                        = keeper.Keeper
+)
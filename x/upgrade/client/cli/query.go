@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client"
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the upgrade module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	upgradeQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryPlan implements a command to return the currently scheduled
+// upgrade plan, if any.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Get upgrade plan (if one exists)",
+		Long:  "Gets the currently scheduled upgrade plan, if one exists",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrent)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err := cdc.UnmarshalJSON(res, &plan); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements a command to return the block header at which
+// a given upgrade was applied.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [upgrade-name]",
+		Short: "Get the block header for the height at which a completed upgrade was applied",
+		Long: "If upgrade-name was previously executed on the chain, this returns the header for the block at which it was applied.\n" +
+			"This helps a client determine which binary was valid over a given range of blocks, as well as more context to understand past migrations.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryApplied)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade found")
+			}
+			if len(res) != 8 {
+				return fmt.Errorf("unknown format for applied-upgrade")
+			}
+			applied := int64(binary.BigEndian.Uint64(res))
+
+			// we got the height, now let's return the headers
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			headers, err := node.BlockchainInfo(applied, applied)
+			if err != nil {
+				return err
+			}
+			if len(headers.BlockMetas) == 0 {
+				return fmt.Errorf("no headers returned for height %d", applied)
+			}
+
+			return cliCtx.PrintOutput(types.NewAppliedUpgrade(args[0], *headers.BlockMetas[0]))
+		},
+	}
+}
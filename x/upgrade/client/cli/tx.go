@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/version"
+	"github.com/hyperspeednetwork/hsnhub/x/auth"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	govcli "github.com/hyperspeednetwork/hsnhub/x/gov/client/cli"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types"
+)
+
+// TimeFormat specifies ISO UTC format for submitting the time for a new upgrade proposal
+const TimeFormat = "2006-01-02T15:04:05Z"
+
+// Upgrade proposal flags
+const (
+	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeTime   = "upgrade-time"
+	FlagUpgradeInfo   = "upgrade-info"
+)
+
+func parseArgsToContent(cmd *cobra.Command, name string) (govtypes.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return nil, err
+	}
+
+	height, err := cmd.Flags().GetInt64(FlagUpgradeHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	timeStr, err := cmd.Flags().GetString(FlagUpgradeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if height != 0 && len(timeStr) != 0 {
+		return nil, fmt.Errorf("only one of --%s or --%s should be specified", FlagUpgradeTime, FlagUpgradeHeight)
+	}
+
+	var upgradeTime time.Time
+	if len(timeStr) != 0 {
+		upgradeTime, err = time.Parse(TimeFormat, timeStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := cmd.Flags().GetString(FlagUpgradeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := types.NewPlan(name, upgradeTime, height, info)
+	content := types.NewSoftwareUpgradeProposal(title, description, plan)
+	return content, nil
+}
+
+// GetCmdSubmitUpgradeProposal implements a command handler for submitting a
+// software upgrade proposal transaction.
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) (--upgrade-info [info]) [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade along with an initial deposit.
+The upgrade is scheduled either at a block height or at a UTC time (%s).
+Nodes running a binary without a handler for the upgrade name will halt at
+that point and write the upgrade details to data/upgrade-info.json.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade v2 --upgrade-height=100000 --upgrade-info="commit 0a1b2c" --title="Upgrade to v2" --description="..." --deposit="10000stake" --from=<key_or_address>
+`,
+				TimeFormat, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			content, err := parseArgsToContent(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
+	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
+	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements a command handler for
+// submitting a software upgrade cancellation proposal transaction.
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to cancel the currently scheduled software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the currently scheduled software upgrade along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade --title="Cancel v2" --description="..." --deposit="10000stake" --from=<key_or_address>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelSoftwareUpgradeProposal(title, description)
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
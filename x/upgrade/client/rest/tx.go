@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hyperspeednetwork/hsnhub/client/context"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/types/rest"
+	"github.com/hyperspeednetwork/hsnhub/x/auth/client/utils"
+	govrest "github.com/hyperspeednetwork/hsnhub/x/gov/client/rest"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types"
+)
+
+type (
+	// PlanRequest defines a software upgrade proposal request body.
+	PlanRequest struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title         string         `json:"title" yaml:"title"`
+		Description   string         `json:"description" yaml:"description"`
+		Deposit       sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer      sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		UpgradeName   string         `json:"name" yaml:"name"`
+		UpgradeHeight int64          `json:"upgrade_height" yaml:"upgrade_height"`
+		UpgradeTime   string         `json:"upgrade_time" yaml:"upgrade_time"`
+		UpgradeInfo   string         `json:"info" yaml:"info"`
+	}
+
+	// CancelRequest defines a cancel software upgrade proposal request body.
+	CancelRequest struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+)
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the software
+// upgrade REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "upgrade",
+		Handler:  postPlanHandler(cliCtx),
+	}
+}
+
+// ProposalCancelRESTHandler returns a ProposalRESTHandler that exposes the
+// cancel software upgrade REST handler with a given sub-route.
+func ProposalCancelRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_upgrade",
+		Handler:  cancelPlanHandler(cliCtx),
+	}
+}
+
+func postPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlanRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var t time.Time
+		if req.UpgradeTime != "" {
+			var err error
+			t, err = time.Parse(time.RFC3339, req.UpgradeTime)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		plan := types.NewPlan(req.UpgradeName, t, req.UpgradeHeight, req.UpgradeInfo)
+		content := types.NewSoftwareUpgradeProposal(req.Title, req.Description, plan)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelPlanHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelSoftwareUpgradeProposal(req.Title, req.Description)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
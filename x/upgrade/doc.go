@@ -0,0 +1,31 @@
+/*
+Package upgrade provides a governance driven mechanism for coordinating
+software upgrades of a live chain.
+
+A SoftwareUpgradeProposal carries a Plan with a name and either a block height
+or a time at which the upgrade must happen. Once the proposal passes the plan is
+stored, and the module's BeginBlocker checks it at every block:
+
+  - before the plan is due, a binary that already has an UpgradeHandler
+    registered for the plan name panics, so operators cannot switch binaries
+    early;
+  - when the plan is due and the running binary has a handler for it, the
+    handler is executed to perform any state migration, the plan is cleared and
+    the upgrade is recorded as done at the current height;
+  - when the plan is due and the running binary has no handler for it, the node
+    writes the plan to <home>/data/upgrade-info.json and halts. Operators then
+    restart the node with the new binary.
+
+A scheduled plan can be aborted with a CancelSoftwareUpgradeProposal. Scheduling
+a new plan overwrites any plan that is already pending.
+
+New binaries register their migrations when building the app, eg.
+
+	app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) {
+		// migrate state here
+	})
+
+Store renames and deletions that accompany an upgrade can be applied with the
+store loader returned by UpgradeStoreLoader.
+*/
+package upgrade
@@ -0,0 +1,40 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+// NewSoftwareUpgradeProposalHandler creates a governance handler to manage new
+// proposal types. It enables SoftwareUpgradeProposal to propose an Upgrade,
+// and CancelSoftwareUpgradeProposal to abort a previously voted upgrade.
+func NewSoftwareUpgradeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized software upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p SoftwareUpgradeProposal) sdk.Error {
+	return k.ScheduleUpgrade(ctx, p.Plan)
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, _ CancelSoftwareUpgradeProposal) sdk.Error {
+	if _, found := k.GetUpgradePlan(ctx); !found {
+		return ErrNoUpgradePlan(DefaultCodespace)
+	}
+
+	k.ClearUpgradePlan(ctx)
+	return nil
+}
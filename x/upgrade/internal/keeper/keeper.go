@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/hyperspeednetwork/hsnhub/codec"
+	"github.com/hyperspeednetwork/hsnhub/store/prefix"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	"github.com/hyperspeednetwork/hsnhub/x/upgrade/internal/types"
+)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	homePath        string
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper constructs an upgrade Keeper. The homePath is the node's home
+// directory, under which the upgrade info file is written when the chain halts
+// for an upgrade.
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, homePath string) Keeper {
+	return Keeper{
+		homePath:        homePath,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		upgradeHandlers: map[string]types.UpgradeHandler{},
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name.
+// This handler will be called when the upgrade with this name is applied. In
+// order for an upgrade with the given name to proceed, a handler for this
+// upgrade must be set even if it is a no-op function.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true iff there is a handler registered for this name
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan. If there
+// is another Plan already scheduled, it will overwrite it (the latest
+// governance decision wins).
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !plan.Time.IsZero() {
+		if !plan.Time.After(ctx.BlockHeader().Time) {
+			return types.ErrInvalidPlan(types.DefaultCodespace, "upgrade cannot be scheduled in the past")
+		}
+	} else if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(types.DefaultCodespace, "upgrade cannot be scheduled in the past")
+	}
+
+	if k.GetDoneHeight(ctx, plan.Name) != 0 {
+		return types.ErrInvalidPlan(types.DefaultCodespace, fmt.Sprintf("upgrade with name %s has already been completed", plan.Name))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(plan)
+	store.Set(types.PlanKey(), bz)
+
+	return nil
+}
+
+// GetDoneHeight returns the height at which the given upgrade was executed,
+// or zero if it was never applied
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DoneKey(name))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// IterateDoneUpgrades iterates over all applied upgrades, calling cb with the
+// upgrade name and the height at which it was applied
+func (k Keeper) IterateDoneUpgrades(ctx sdk.Context, cb func(name string, height int64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DoneByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		height := int64(binary.BigEndian.Uint64(iterator.Value()))
+		if cb(string(iterator.Key()), height) {
+			break
+		}
+	}
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey())
+}
+
+// GetUpgradePlan returns the currently scheduled Plan if any, setting havePlan
+// to true if there is a scheduled upgrade or false if there is none
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey())
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// setDone marks this upgrade name as being done so the name can't be reused
+// accidentally
+func (k Keeper) setDone(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	store.Set(types.DoneKey(name), bz)
+}
+
+// ApplyUpgrade will execute the handler associated with the Plan and mark the
+// plan as done.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		panic("ApplyUpgrade should never be called without first checking HasHandler")
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
+
+// GetUpgradeInfoPath returns the path of the upgrade info file inside the
+// node's data directory, creating the directory if needed.
+func (k Keeper) GetUpgradeInfoPath() (string, error) {
+	upgradeInfoFileDir := filepath.Join(k.homePath, "data")
+	if err := cmn.EnsureDir(upgradeInfoFileDir, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(upgradeInfoFileDir, types.UpgradeInfoFileName), nil
+}
+
+// DumpUpgradeInfoToDisk writes the upgrade info file so that the operator (or
+// a process manager) can tell which upgrade the node halted for.
+func (k Keeper) DumpUpgradeInfoToDisk(height int64, plan types.Plan) error {
+	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return err
+	}
+
+	info := types.UpgradeInfo{
+		Name:   plan.Name,
+		Height: height,
+		Info:   plan.Info,
+	}
+
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(upgradeInfoFilePath, bz, 0600)
+}
+
+// ReadUpgradeInfoFromDisk returns the upgrade info written by a previous halt.
+// It returns an empty UpgradeInfo if the file does not exist.
+func (k Keeper) ReadUpgradeInfoFromDisk() (types.UpgradeInfo, error) {
+	var info types.UpgradeInfo
+
+	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return info, err
+	}
+
+	bz, err := ioutil.ReadFile(upgradeInfoFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return info, nil
+		}
+		return info, err
+	}
+
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return info, err
+	}
+
+	return info, nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// nolint
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan       sdk.CodeType = 101
+	CodeUpgradeNotApplied sdk.CodeType = 102
+	CodeNoUpgradePlan     sdk.CodeType = 103
+)
+
+// ErrInvalidPlan returns an error when an upgrade plan fails validation
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, "invalid upgrade plan: %s", msg)
+}
+
+// ErrNoUpgradePlan returns an error when there is no scheduled upgrade plan to
+// act upon
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "there is no upgrade plan scheduled")
+}
+
+// ErrUpgradeNotApplied returns an error when querying for an upgrade that was
+// never applied
+func ErrUpgradeNotApplied(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeNotApplied, "upgrade %s has not been applied", name)
+}
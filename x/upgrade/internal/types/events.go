@@ -0,0 +1,11 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeUpgrade = "upgrade"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+
+	AttributeValueCategory = ModuleName
+)
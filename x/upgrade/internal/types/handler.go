@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// UpgradeHandler specifies the type of function that is called when an upgrade
+// is applied. It runs the state migrations required by the new binary and must
+// be registered with the keeper before the upgrade height is reached.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
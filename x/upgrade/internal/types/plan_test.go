@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+func TestPlanValidateBasic(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name  string
+		plan  Plan
+		valid bool
+	}{
+		{"height", NewPlan("v2", time.Time{}, 100, ""), true},
+		{"time", NewPlan("v2", now, 0, "info"), true},
+		{"empty name", NewPlan(" ", time.Time{}, 100, ""), false},
+		{"no height or time", NewPlan("v2", time.Time{}, 0, ""), false},
+		{"both height and time", NewPlan("v2", now, 100, ""), false},
+		{"negative height", NewPlan("v2", time.Time{}, -1, ""), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.plan.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPlanShouldExecute(t *testing.T) {
+	now := time.Now()
+	ctx := sdk.NewContext(nil, abci.Header{Height: 100, Time: now}, false, log.NewNopLogger())
+
+	require.True(t, NewPlan("v2", time.Time{}, 99, "").ShouldExecute(ctx))
+	require.True(t, NewPlan("v2", time.Time{}, 100, "").ShouldExecute(ctx))
+	require.False(t, NewPlan("v2", time.Time{}, 101, "").ShouldExecute(ctx))
+
+	require.True(t, NewPlan("v2", now.Add(-time.Second), 0, "").ShouldExecute(ctx))
+	require.True(t, NewPlan("v2", now, 0, "").ShouldExecute(ctx))
+	require.False(t, NewPlan("v2", now.Add(time.Second), 0, "").ShouldExecute(ctx))
+}
+
+func TestPlanDueAt(t *testing.T) {
+	require.Equal(t, "height: 100", NewPlan("v2", time.Time{}, 100, "").DueAt())
+
+	due := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.Equal(t, "time: 2020-01-02T03:04:05Z", NewPlan("v2", due, 0, "").DueAt())
+}
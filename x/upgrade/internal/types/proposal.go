@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+	govtypes "github.com/hyperspeednetwork/hsnhub/x/gov/types"
+)
+
+const (
+	// ProposalTypeSoftwareUpgrade defines the type for a SoftwareUpgradeProposal
+	ProposalTypeSoftwareUpgrade string = "SoftwareUpgrade"
+
+	// ProposalTypeCancelSoftwareUpgrade defines the type for a CancelSoftwareUpgradeProposal
+	ProposalTypeCancelSoftwareUpgrade string = "CancelSoftwareUpgrade"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SoftwareUpgradeProposal{}
+	_ govtypes.Content = CancelSoftwareUpgradeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelSoftwareUpgrade)
+	govtypes.RegisterProposalTypeCodec(CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+}
+
+// SoftwareUpgradeProposal is a gov Content type for initiating a software upgrade
+type SoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Plan        Plan   `json:"plan" yaml:"plan"`
+}
+
+// NewSoftwareUpgradeProposal creates a new software upgrade proposal.
+func NewSoftwareUpgradeProposal(title, description string, plan Plan) SoftwareUpgradeProposal {
+	return SoftwareUpgradeProposal{title, description, plan}
+}
+
+// GetTitle returns the title of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetTitle() string { return sup.Title }
+
+// GetDescription returns the description of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) GetDescription() string { return sup.Description }
+
+// ProposalRoute returns the routing key of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a software upgrade proposal.
+func (sup SoftwareUpgradeProposal) ProposalType() string { return ProposalTypeSoftwareUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (sup SoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	if err := sup.Plan.ValidateBasic(); err != nil {
+		return err
+	}
+	return govtypes.ValidateAbstract(DefaultCodespace, sup)
+}
+
+// String implements the Stringer interface.
+func (sup SoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+  %s
+`, sup.Title, sup.Description, sup.Plan)
+}
+
+// CancelSoftwareUpgradeProposal is a gov Content type for cancelling a
+// scheduled software upgrade
+type CancelSoftwareUpgradeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+}
+
+// NewCancelSoftwareUpgradeProposal creates a new cancel software upgrade proposal.
+func NewCancelSoftwareUpgradeProposal(title, description string) CancelSoftwareUpgradeProposal {
+	return CancelSoftwareUpgradeProposal{title, description}
+}
+
+// GetTitle returns the title of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetTitle() string { return csup.Title }
+
+// GetDescription returns the description of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) GetDescription() string { return csup.Description }
+
+// ProposalRoute returns the routing key of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel software upgrade proposal.
+func (csup CancelSoftwareUpgradeProposal) ProposalType() string {
+	return ProposalTypeCancelSoftwareUpgrade
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (csup CancelSoftwareUpgradeProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, csup)
+}
+
+// String implements the Stringer interface.
+func (csup CancelSoftwareUpgradeProposal) String() string {
+	return fmt.Sprintf(`Cancel Software Upgrade Proposal:
+  Title:       %s
+  Description: %s
+`, csup.Title, csup.Description)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// QueryAppliedParams is passed as data with QueryApplied
+type QueryAppliedParams struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+// NewQueryAppliedParams creates a new instance to query
+// if a named upgrade has been applied
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}
+
+// AppliedUpgrade defines the block at which a named upgrade was applied, as
+// printed by the applied upgrade query
+type AppliedUpgrade struct {
+	Name      string            `json:"name" yaml:"name"`
+	BlockMeta tmtypes.BlockMeta `json:"block_meta" yaml:"block_meta"`
+}
+
+// NewAppliedUpgrade creates a new AppliedUpgrade instance
+func NewAppliedUpgrade(name string, blockMeta tmtypes.BlockMeta) AppliedUpgrade {
+	return AppliedUpgrade{Name: name, BlockMeta: blockMeta}
+}
+
+// String implements the Stringer interface
+func (u AppliedUpgrade) String() string {
+	return fmt.Sprintf(`Applied Upgrade:
+  Name:     %s
+  Block ID: %s
+  %s`, u.Name, u.BlockMeta.BlockID, u.BlockMeta.Header.StringIndented("  "))
+}
+
+// MarshalYAML returns the YAML representation of the applied upgrade. The
+// header is printed as text, its hashes would otherwise be listed byte by byte.
+func (u AppliedUpgrade) MarshalYAML() (interface{}, error) {
+	return u.String(), nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/hyperspeednetwork/hsnhub/baseapp"
+	storetypes "github.com/hyperspeednetwork/hsnhub/store/types"
+	sdk "github.com/hyperspeednetwork/hsnhub/types"
+)
+
+// UpgradeStoreLoader returns a StoreLoader that applies the given store
+// upgrades (renamed or deleted stores) only when the last committed height
+// equals the upgrade height. Any other time it behaves like the default
+// store loader, so it is safe to leave configured after the upgrade.
+func UpgradeStoreLoader(upgradeHeight int64, storeUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if upgradeHeight == ms.LastCommitID().Version {
+			// check if the current commit version and upgrade height matches
+			if len(storeUpgrades.Renamed) > 0 || len(storeUpgrades.Deleted) > 0 {
+				return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+			}
+		}
+
+		// otherwise load default store loader
+		return baseapp.DefaultStoreLoader(ms)
+	}
+}
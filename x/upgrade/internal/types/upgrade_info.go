@@ -0,0 +1,14 @@
+package types
+
+// UpgradeInfoFileName is the name of the file written to the node's data
+// directory when the chain halts for an upgrade this binary cannot apply.
+const UpgradeInfoFileName = "upgrade-info.json"
+
+// UpgradeInfo is the content of the upgrade info file. It gives node operators
+// (and any process supervising the node) the information needed to switch to
+// the binary that knows how to apply the upgrade.
+type UpgradeInfo struct {
+	Name   string `json:"name" yaml:"name"`
+	Height int64  `json:"height" yaml:"height"`
+	Info   string `json:"info,omitempty" yaml:"info,omitempty"`
+}